@@ -3,6 +3,7 @@ package config
 import (
 	"io/ioutil"
 	"net/http"
+	"time"
 
 	"gopkg.in/yaml.v2"
 )
@@ -27,8 +28,8 @@ type (
 
 	// Auth basic auth creds
 	Auth struct {
-		User     string `yaml:"user"`
-		Password string `yaml:"password"`
+		User     string `yaml:"user" json:"user,omitempty"`
+		Password string `yaml:"password" json:"-"`
 	}
 
 	// Benchmark represents a generic benchmark.
@@ -39,21 +40,49 @@ type (
 
 	// HTTP represents an http request.
 	HTTP struct {
-		Method  string      `yaml:"method"`
-		Host    string      `yaml:"host"`
-		Path    string      `yaml:"path"`
-		HTTP2   bool        `yaml:"http2"`
-		Body    string      `yaml:"body"`
-		Headers http.Header `yaml:"headers"`
+		Method  string      `yaml:"method" json:"method"`
+		Host    string      `yaml:"host" json:"host,omitempty"`
+		Path    string      `yaml:"path" json:"path"`
+		HTTP2   bool        `yaml:"http2" json:"http2"`
+		Body    string      `yaml:"body" json:"body,omitempty"`
+		Headers http.Header `yaml:"headers" json:"headers,omitempty"`
 	}
 
 	// BenchConfig represents a service benchmark.
 	BenchConfig struct {
-		C    int  `yaml:"concurrency"`
-		N    int  `yaml:"requests"`
-		Auth Auth `yaml:"auth"`
-		HTTP HTTP `yaml:"http"`
-		Name string
+		C        int           `yaml:"concurrency" json:"concurrency"`
+		N        int           `yaml:"requests" json:"requests"`
+		QPS      float64       `yaml:"qps" json:"qps,omitempty"`
+		Duration time.Duration `yaml:"duration" json:"duration,omitempty"`
+		Warmup   time.Duration `yaml:"warmup" json:"warmup,omitempty"`
+		Auth     Auth          `yaml:"auth" json:"auth"`
+		HTTP     HTTP          `yaml:"http" json:"http"`
+		Scenario *Scenario     `yaml:"scenario" json:"scenario,omitempty"`
+		Name     string        `json:"name"`
+	}
+
+	// Scenario represents a sequence of benchmark requests. Steps run in order
+	// unless weighted, in which case each request picks a step based on its
+	// weight. Weighted scenarios run zero weight steps once per worker upfront.
+	Scenario struct {
+		Weighted bool   `yaml:"weighted" json:"weighted,omitempty"`
+		Steps    []Step `yaml:"steps" json:"steps"`
+	}
+
+	// Step represents a scenario request.
+	Step struct {
+		Name    string    `yaml:"name" json:"name"`
+		Weight  int       `yaml:"weight" json:"weight,omitempty"`
+		HTTP    HTTP      `yaml:"http" json:"http"`
+		Capture []Capture `yaml:"capture" json:"capture,omitempty"`
+	}
+
+	// Capture extracts a response header or json field into a variable
+	// available to subsequent requests as ${name}.
+	Capture struct {
+		Name   string `yaml:"name" json:"name"`
+		Header string `yaml:"header" json:"header,omitempty"`
+		JSON   string `yaml:"json" json:"json,omitempty"`
 	}
 )
 
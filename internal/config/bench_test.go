@@ -3,6 +3,7 @@ package config
 import (
 	"net/http"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -184,3 +185,60 @@ func TestBenchContainerLoad(t *testing.T) {
 		})
 	}
 }
+
+func TestBenchSoakLoad(t *testing.T) {
+	uu := map[string]struct {
+		key              string
+		c, n             int
+		qps              float64
+		duration, warmup time.Duration
+	}{
+		"soak": {
+			key:      "default/nginx",
+			c:        4,
+			qps:      20,
+			duration: 5 * time.Minute,
+			warmup:   30 * time.Second,
+		},
+		"plain": {
+			key: "blee/fred",
+			c:   2,
+			n:   500,
+		},
+	}
+
+	for k, u := range uu {
+		t.Run(k, func(t *testing.T) {
+			b, err := NewBench("test_assets/b_soak.yml")
+
+			assert.Nil(t, err)
+			svc := b.Benchmarks.Services[u.key]
+			assert.Equal(t, u.c, svc.C)
+			assert.Equal(t, u.n, svc.N)
+			assert.Equal(t, u.qps, svc.QPS)
+			assert.Equal(t, u.duration, svc.Duration)
+			assert.Equal(t, u.warmup, svc.Warmup)
+		})
+	}
+}
+
+func TestBenchScenarioLoad(t *testing.T) {
+	b, err := NewBench("test_assets/b_scenario.yml")
+	assert.Nil(t, err)
+
+	api := b.Benchmarks.Services["default/api"].Scenario
+	assert.NotNil(t, api)
+	assert.False(t, api.Weighted)
+	assert.Equal(t, 2, len(api.Steps))
+	assert.Equal(t, "login", api.Steps[0].Name)
+	assert.Equal(t, "POST", api.Steps[0].HTTP.Method)
+	assert.Equal(t, []Capture{{Name: "token", JSON: "data.token"}, {Name: "session", Header: "X-Session"}}, api.Steps[0].Capture)
+	assert.Equal(t, "Bearer ${token}", api.Steps[1].HTTP.Headers.Get("Authorization"))
+
+	mix := b.Benchmarks.Services["default/mix"].Scenario
+	assert.NotNil(t, mix)
+	assert.True(t, mix.Weighted)
+	assert.Equal(t, []int{0, 3, 1}, []int{mix.Steps[0].Weight, mix.Steps[1].Weight, mix.Steps[2].Weight})
+
+	assert.Nil(t, b.Benchmarks.Services["default/nginx"].Scenario)
+}
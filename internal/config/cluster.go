@@ -2,8 +2,9 @@ package config
 
 // Cluster tracks K9s cluster configuration.
 type Cluster struct {
-	Namespace *Namespace `yaml:"namespace"`
-	View      *View      `yaml:"view"`
+	Namespace    *Namespace     `yaml:"namespace"`
+	View         *View          `yaml:"view"`
+	PortForwards []*PortForward `yaml:"portForwards,omitempty"`
 }
 
 // NewCluster creates a new cluster configuration.
@@ -22,4 +23,5 @@ func (c *Cluster) Validate(conn Connection, ks KubeSettings) {
 		c.View = NewView()
 	}
 	c.View.Validate()
+	c.validatePortForwards()
 }
@@ -0,0 +1,42 @@
+package config
+
+var defaultJSONLogFields = []string{"time", "level", "msg"}
+
+// JSONLog tracks structured log rendering options.
+type JSONLog struct {
+	Fields     []string            `yaml:"fields"`
+	Containers map[string][]string `yaml:"containers,omitempty"`
+}
+
+// NewJSONLog creates a new structured log configuration.
+func NewJSONLog() *JSONLog {
+	return &JSONLog{
+		Fields:     defaultJSONLogFields,
+		Containers: make(map[string][]string),
+	}
+}
+
+// HasContainer checks if a container defines its own field template.
+func (j *JSONLog) HasContainer(co string) bool {
+	if j == nil {
+		return false
+	}
+	_, ok := j.Containers[co]
+
+	return ok
+}
+
+// FieldsFor returns the fields to display for a given container.
+func (j *JSONLog) FieldsFor(co string) []string {
+	if j == nil {
+		return defaultJSONLogFields
+	}
+	if ff, ok := j.Containers[co]; ok && len(ff) > 0 {
+		return ff
+	}
+	if len(j.Fields) > 0 {
+		return j.Fields
+	}
+
+	return defaultJSONLogFields
+}
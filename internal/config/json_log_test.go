@@ -0,0 +1,42 @@
+package config_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestJSONLogFieldsFor(t *testing.T) {
+	j := config.NewJSONLog()
+	j.Containers["nginx"] = []string{"ts", "severity", "message"}
+	j.Containers["blank"] = []string{}
+
+	uu := map[string]struct {
+		j  *config.JSONLog
+		co string
+		e  []string
+	}{
+		"nil":       {nil, "nginx", []string{"time", "level", "msg"}},
+		"default":   {j, "fred", []string{"time", "level", "msg"}},
+		"container": {j, "nginx", []string{"ts", "severity", "message"}},
+		"blank":     {j, "blank", []string{"time", "level", "msg"}},
+	}
+
+	for k, u := range uu {
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, u.j.FieldsFor(u.co))
+		})
+	}
+}
+
+func TestJSONLogHasContainer(t *testing.T) {
+	j := config.NewJSONLog()
+	j.Containers["nginx"] = []string{"msg"}
+
+	assert.True(t, j.HasContainer("nginx"))
+	assert.False(t, j.HasContainer("fred"))
+
+	var n *config.JSONLog
+	assert.False(t, n.HasContainer("nginx"))
+}
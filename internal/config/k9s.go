@@ -19,6 +19,7 @@ type K9s struct {
 	CurrentContext    string              `yaml:"currentContext"`
 	CurrentCluster    string              `yaml:"currentCluster"`
 	Clusters          map[string]*Cluster `yaml:"clusters,omitempty"`
+	JSONLog           *JSONLog            `yaml:"jsonLog,omitempty"`
 	manualRefreshRate int
 	manualHeadless    *bool
 	manualCommand     *string
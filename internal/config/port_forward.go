@@ -0,0 +1,76 @@
+package config
+
+import "github.com/rs/zerolog/log"
+
+// PortForward tracks a named port forward definition.
+type PortForward struct {
+	Name      string   `yaml:"name"`
+	Context   string   `yaml:"context,omitempty"`
+	Namespace string   `yaml:"namespace"`
+	Selector  string   `yaml:"selector"`
+	Container string   `yaml:"container,omitempty"`
+	Ports     []string `yaml:"ports"`
+	AutoStart bool     `yaml:"autoStart,omitempty"`
+}
+
+// Matches checks if the port forward applies to the given context.
+func (p *PortForward) Matches(ctx string) bool {
+	return p.Context == "" || p.Context == ctx
+}
+
+// Valid checks if the port forward definition is usable.
+func (p *PortForward) Valid() bool {
+	return p != nil && p.Name != "" && p.Selector != "" && len(p.Ports) > 0
+}
+
+// PortForwardsFor returns the valid port forward definitions for a given context.
+func (c *Cluster) PortForwardsFor(ctx string) []*PortForward {
+	pp, names := make([]*PortForward, 0, len(c.PortForwards)), make(map[string]bool)
+	for _, p := range c.PortForwards {
+		if !p.Valid() || names[p.Name] {
+			continue
+		}
+		names[p.Name] = true
+		if p.Matches(ctx) {
+			pp = append(pp, p)
+		}
+	}
+
+	return pp
+}
+
+// PortForward returns a valid port forward definition by name.
+func (c *Cluster) PortForward(name string) (*PortForward, bool) {
+	for _, p := range c.PortForwards {
+		if p.Valid() && p.Name == name {
+			return p, true
+		}
+	}
+
+	return nil, false
+}
+
+// ValidatePortForwards flags invalid or duplicate definitions. Offending
+// entries are kept so they are not lost when the config is saved back.
+func (c *Cluster) validatePortForwards() {
+	pp, names := make([]*PortForward, 0, len(c.PortForwards)), make(map[string]bool)
+	for _, p := range c.PortForwards {
+		if p == nil {
+			continue
+		}
+		pp = append(pp, p)
+		if !p.Valid() {
+			log.Warn().Msgf("Skipping invalid port forward %q. Name, selector and ports are required", p.Name)
+			continue
+		}
+		if names[p.Name] {
+			log.Warn().Msgf("Skipping duplicate port forward %q", p.Name)
+			continue
+		}
+		if p.Namespace == "" {
+			p.Namespace = defaultNS
+		}
+		names[p.Name] = true
+	}
+	c.PortForwards = pp
+}
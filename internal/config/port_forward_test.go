@@ -0,0 +1,75 @@
+package config_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	m "github.com/petergtz/pegomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPortForwardsValidate(t *testing.T) {
+	mc := NewMockConnection()
+	m.When(mc.ValidNamespaces()).ThenReturn(namespaces(), nil)
+
+	mk := NewMockKubeSettings()
+	m.When(mk.NamespaceNames(namespaces())).ThenReturn([]string{"ns1", "ns2", "default"})
+
+	c := config.NewCluster()
+	c.PortForwards = []*config.PortForward{
+		{Name: "web", Selector: "app=web", Ports: []string{"8080:80"}},
+		{Name: "web", Selector: "app=blee", Ports: []string{"8081:80"}},
+		{Name: "noports", Selector: "app=fred"},
+		{Name: "nosel", Ports: []string{"9090"}},
+		nil,
+		{Name: "db", Namespace: "data", Selector: "app=db", Ports: []string{"5432"}},
+	}
+	c.Validate(mc, mk)
+
+	assert.Equal(t, 5, len(c.PortForwards))
+	assert.Equal(t, "default", c.PortForwards[0].Namespace)
+	assert.Equal(t, "app=blee", c.PortForwards[1].Selector)
+	assert.Equal(t, "noports", c.PortForwards[2].Name)
+	assert.Equal(t, "nosel", c.PortForwards[3].Name)
+
+	pp := c.PortForwardsFor("")
+	assert.Equal(t, 2, len(pp))
+	assert.Equal(t, "app=web", pp[0].Selector)
+	assert.Equal(t, "data", pp[1].Namespace)
+	_, ok := c.PortForward("noports")
+	assert.False(t, ok)
+}
+
+func TestPortForwardsFor(t *testing.T) {
+	c := config.NewCluster()
+	c.PortForwards = []*config.PortForward{
+		{Name: "any", Selector: "app=web", Ports: []string{"8080:80"}},
+		{Name: "fred", Context: "fred", Selector: "app=web", Ports: []string{"8080:80"}},
+		{Name: "blee", Context: "blee", Selector: "app=web", Ports: []string{"8080:80"}},
+	}
+
+	uu := map[string]struct {
+		ctx string
+		e   []string
+	}{
+		"fred":  {"fred", []string{"any", "fred"}},
+		"blee":  {"blee", []string{"any", "blee"}},
+		"other": {"zorg", []string{"any"}},
+	}
+
+	for k, u := range uu {
+		t.Run(k, func(t *testing.T) {
+			var nn []string
+			for _, p := range c.PortForwardsFor(u.ctx) {
+				nn = append(nn, p.Name)
+			}
+			assert.Equal(t, u.e, nn)
+		})
+	}
+
+	p, ok := c.PortForward("fred")
+	assert.True(t, ok)
+	assert.Equal(t, "fred", p.Context)
+	_, ok = c.PortForward("zorg")
+	assert.False(t, ok)
+}
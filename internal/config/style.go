@@ -55,8 +55,12 @@ type (
 
 	// Log tracks Log styles.
 	Log struct {
-		FgColor string `yaml:"fgColor"`
-		BgColor string `yaml:"bgColor"`
+		FgColor    string `yaml:"fgColor"`
+		BgColor    string `yaml:"bgColor"`
+		ErrorColor string `yaml:"errorColor"`
+		WarnColor  string `yaml:"warnColor"`
+		InfoColor  string `yaml:"infoColor"`
+		DebugColor string `yaml:"debugColor"`
 	}
 
 	// Yaml tracks yaml styles.
@@ -177,8 +181,12 @@ func newStatus() Status {
 // NewLog returns a new log style.
 func newLog() Log {
 	return Log{
-		FgColor: "lightskyblue",
-		BgColor: "black",
+		FgColor:    "lightskyblue",
+		BgColor:    "black",
+		ErrorColor: "orangered",
+		WarnColor:  "orange",
+		InfoColor:  "lightskyblue",
+		DebugColor: "gray",
 	}
 }
 
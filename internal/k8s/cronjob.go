@@ -1,13 +1,23 @@
 package k8s
 
 import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
 	batchv1 "k8s.io/api/batch/v1"
 	batchv1beta1 "k8s.io/api/batch/v1beta1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/rand"
 )
 
-const maxJobNameSize = 42
+const (
+	maxJobNameSize = 42
+
+	scheduledTimestampAnnotation = "batch.kubernetes.io/cronjob-scheduled-timestamp"
+)
 
 // CronJob represents a Kubernetes CronJob.
 type CronJob struct {
@@ -62,6 +72,9 @@ func (c *CronJob) Run(ns, n string) error {
 			Name:      jobName + "-manual-" + rand.String(3),
 			Namespace: ns,
 			Labels:    cronJob.Spec.JobTemplate.Labels,
+			OwnerReferences: []metav1.OwnerReference{
+				*metav1.NewControllerRef(cronJob, batchv1beta1.SchemeGroupVersion.WithKind("CronJob")),
+			},
 		},
 		Spec: cronJob.Spec.JobTemplate.Spec,
 	}
@@ -69,3 +82,54 @@ func (c *CronJob) Run(ns, n string) error {
 	_, err = c.DialOrDie().BatchV1().Jobs(ns).Create(job)
 	return err
 }
+
+// Suspend a CronJob.
+func (c *CronJob) Suspend(ns, n string) error {
+	return c.setSuspend(ns, n, true)
+}
+
+// Resume a suspended CronJob.
+func (c *CronJob) Resume(ns, n string) error {
+	return c.setSuspend(ns, n, false)
+}
+
+// IsSuspended checks if a CronJob is suspended.
+func (c *CronJob) IsSuspended(ns, n string) (bool, error) {
+	cj, err := c.DialOrDie().BatchV1beta1().CronJobs(ns).Get(n, metav1.GetOptions{})
+	if err != nil {
+		return false, err
+	}
+
+	return cj.Spec.Suspend != nil && *cj.Spec.Suspend, nil
+}
+
+func (c *CronJob) setSuspend(ns, n string, suspend bool) error {
+	patch := fmt.Sprintf(`{"spec":{"suspend":%t}}`, suspend)
+	_, err := c.DialOrDie().BatchV1beta1().CronJobs(ns).Patch(n, types.StrategicMergePatchType, []byte(patch))
+
+	return err
+}
+
+// JobScheduledTime returns the time a CronJob scheduled a given Job. The time
+// is read off the scheduled timestamp annotation or the job name suffix.
+func JobScheduledTime(jo *batchv1.Job) (time.Time, bool) {
+	ref := metav1.GetControllerOf(jo)
+	if ref == nil || ref.Kind != "CronJob" {
+		return time.Time{}, false
+	}
+	if ts, ok := jo.Annotations[scheduledTimestampAnnotation]; ok {
+		t, err := time.Parse(time.RFC3339, ts)
+		return t, err == nil
+	}
+
+	i := strings.LastIndex(jo.Name, "-")
+	if i == -1 {
+		return time.Time{}, false
+	}
+	mins, err := strconv.ParseInt(jo.Name[i+1:], 10, 64)
+	if err != nil {
+		return time.Time{}, false
+	}
+
+	return time.Unix(mins*60, 0).UTC(), true
+}
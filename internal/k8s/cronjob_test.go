@@ -0,0 +1,48 @@
+package k8s
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	batchv1 "k8s.io/api/batch/v1"
+)
+
+func TestJobScheduledTime(t *testing.T) {
+	annotated := batchv1.Job{ObjectMeta: ownedMeta("fred-manual-x1z", "j1", "CronJob", "fred")}
+	annotated.Annotations = map[string]string{scheduledTimestampAnnotation: "2019-10-01T12:30:00Z"}
+
+	uu := map[string]struct {
+		job batchv1.Job
+		e   time.Time
+		ok  bool
+	}{
+		"suffix": {
+			job: batchv1.Job{ObjectMeta: ownedMeta("fred-26175330", "j1", "CronJob", "fred")},
+			e:   time.Date(2019, 10, 8, 7, 30, 0, 0, time.UTC),
+			ok:  true,
+		},
+		"annotation": {
+			job: annotated,
+			e:   time.Date(2019, 10, 1, 12, 30, 0, 0, time.UTC),
+			ok:  true,
+		},
+		"manual": {
+			job: batchv1.Job{ObjectMeta: ownedMeta("fred-manual-x1z", "j1", "CronJob", "fred")},
+		},
+		"noDash": {
+			job: batchv1.Job{ObjectMeta: ownedMeta("fred", "j1", "CronJob", "fred")},
+		},
+		"notCronJob": {
+			job: batchv1.Job{ObjectMeta: objMeta("fred-26175330", "j1")},
+		},
+	}
+
+	for k, u := range uu {
+		t.Run(k, func(t *testing.T) {
+			st, ok := JobScheduledTime(&u.job)
+			assert.Equal(t, u.ok, ok)
+			assert.Equal(t, u.e, st)
+		})
+	}
+}
@@ -0,0 +1,185 @@
+package k8s
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+var (
+	podSpecPath      = []string{"spec"}
+	templateSpecPath = []string{"spec", "template", "spec"}
+	jobTemplatePath  = []string{"spec", "jobTemplate", "spec", "template", "spec"}
+)
+
+// ContainerImage represents the image of a pod spec container.
+type ContainerImage struct {
+	Name  string
+	Image string
+	Init  bool
+}
+
+// Images returns a Deployment container images.
+func (d *Deployment) Images(ns, n string) ([]ContainerImage, error) {
+	dp, err := d.DialOrDie().AppsV1().Deployments(ns).Get(n, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	return PodSpecImages(dp.Spec.Template.Spec), nil
+}
+
+// SetImages updates a Deployment container images.
+func (d *Deployment) SetImages(ns, n string, ii []ContainerImage) error {
+	patch, err := ImagePatch("deployment/"+n, templateSpecPath, ii)
+	if err != nil {
+		return err
+	}
+	_, err = d.DialOrDie().AppsV1().Deployments(ns).Patch(n, types.StrategicMergePatchType, patch)
+
+	return err
+}
+
+// Images returns a StatefulSet container images.
+func (s *StatefulSet) Images(ns, n string) ([]ContainerImage, error) {
+	sts, err := s.DialOrDie().AppsV1().StatefulSets(ns).Get(n, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	return PodSpecImages(sts.Spec.Template.Spec), nil
+}
+
+// SetImages updates a StatefulSet container images.
+func (s *StatefulSet) SetImages(ns, n string, ii []ContainerImage) error {
+	patch, err := ImagePatch("statefulset/"+n, templateSpecPath, ii)
+	if err != nil {
+		return err
+	}
+	_, err = s.DialOrDie().AppsV1().StatefulSets(ns).Patch(n, types.StrategicMergePatchType, patch)
+
+	return err
+}
+
+// Images returns a DaemonSet container images.
+func (d *DaemonSet) Images(ns, n string) ([]ContainerImage, error) {
+	ds, err := d.DialOrDie().AppsV1().DaemonSets(ns).Get(n, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	return PodSpecImages(ds.Spec.Template.Spec), nil
+}
+
+// SetImages updates a DaemonSet container images.
+func (d *DaemonSet) SetImages(ns, n string, ii []ContainerImage) error {
+	patch, err := ImagePatch("daemonset/"+n, templateSpecPath, ii)
+	if err != nil {
+		return err
+	}
+	_, err = d.DialOrDie().AppsV1().DaemonSets(ns).Patch(n, types.StrategicMergePatchType, patch)
+
+	return err
+}
+
+// Images returns a CronJob container images.
+func (c *CronJob) Images(ns, n string) ([]ContainerImage, error) {
+	cj, err := c.DialOrDie().BatchV1beta1().CronJobs(ns).Get(n, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	return PodSpecImages(cj.Spec.JobTemplate.Spec.Template.Spec), nil
+}
+
+// SetImages updates a CronJob container images.
+func (c *CronJob) SetImages(ns, n string, ii []ContainerImage) error {
+	patch, err := ImagePatch("cronjob/"+n, jobTemplatePath, ii)
+	if err != nil {
+		return err
+	}
+	_, err = c.DialOrDie().BatchV1beta1().CronJobs(ns).Patch(n, types.StrategicMergePatchType, patch)
+
+	return err
+}
+
+// Images returns a Pod container images.
+func (p *Pod) Images(ns, n string) ([]ContainerImage, error) {
+	po, err := p.DialOrDie().CoreV1().Pods(ns).Get(n, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	return PodSpecImages(po.Spec), nil
+}
+
+// SetImages updates a Pod container images.
+func (p *Pod) SetImages(ns, n string, ii []ContainerImage) error {
+	patch, err := ImagePatch("pod/"+n, podSpecPath, ii)
+	if err != nil {
+		return err
+	}
+	_, err = p.DialOrDie().CoreV1().Pods(ns).Patch(n, types.StrategicMergePatchType, patch)
+
+	return err
+}
+
+// PodSpecImages returns a pod spec init containers images followed by its containers images.
+func PodSpecImages(spec v1.PodSpec) []ContainerImage {
+	ii := make([]ContainerImage, 0, len(spec.InitContainers)+len(spec.Containers))
+	for _, c := range spec.InitContainers {
+		ii = append(ii, ContainerImage{Name: c.Name, Image: c.Image, Init: true})
+	}
+	for _, c := range spec.Containers {
+		ii = append(ii, ContainerImage{Name: c.Name, Image: c.Image})
+	}
+
+	return ii
+}
+
+// ImagePatch builds a strategic merge patch updating the given container images
+// located at the pod spec path. The patch records the change cause on the resource.
+func ImagePatch(res string, path []string, ii []ContainerImage) ([]byte, error) {
+	if len(ii) == 0 {
+		return nil, errors.New("no container images to update")
+	}
+
+	var cc, icc []map[string]string
+	sets := make([]string, 0, len(ii))
+	for _, i := range ii {
+		if i.Image == "" {
+			return nil, fmt.Errorf("image for container %s must not be blank", i.Name)
+		}
+		c := map[string]string{"name": i.Name, "image": i.Image}
+		if i.Init {
+			icc = append(icc, c)
+		} else {
+			cc = append(cc, c)
+		}
+		sets = append(sets, i.Name+"="+i.Image)
+	}
+
+	spec := make(map[string]interface{}, 2)
+	if len(cc) > 0 {
+		spec["containers"] = cc
+	}
+	if len(icc) > 0 {
+		spec["initContainers"] = icc
+	}
+	var patch interface{} = spec
+	for i := len(path) - 1; i >= 0; i-- {
+		patch = map[string]interface{}{path[i]: patch}
+	}
+	patch.(map[string]interface{})["metadata"] = map[string]interface{}{
+		"annotations": map[string]string{
+			ChangeCauseAnnotation: fmt.Sprintf("k9s set image %s %s", res, strings.Join(sets, " ")),
+		},
+	}
+
+	return json.Marshal(patch)
+}
@@ -0,0 +1,76 @@
+package k8s
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+)
+
+func TestPodSpecImages(t *testing.T) {
+	spec := v1.PodSpec{
+		InitContainers: []v1.Container{{Name: "i1", Image: "busybox:1.31"}},
+		Containers:     []v1.Container{{Name: "c1", Image: "fred:1"}, {Name: "c2", Image: "blee:2"}},
+	}
+
+	assert.Equal(t, []ContainerImage{
+		{Name: "i1", Image: "busybox:1.31", Init: true},
+		{Name: "c1", Image: "fred:1"},
+		{Name: "c2", Image: "blee:2"},
+	}, PodSpecImages(spec))
+}
+
+func TestImagePatch(t *testing.T) {
+	uu := map[string]struct {
+		res  string
+		path []string
+		ii   []ContainerImage
+		e    string
+		err  string
+	}{
+		"template": {
+			res:  "deployment/fred",
+			path: templateSpecPath,
+			ii:   []ContainerImage{{Name: "c1", Image: "fred:2"}, {Name: "i1", Image: "busybox:1.32", Init: true}},
+			e: `{"metadata":{"annotations":{"kubernetes.io/change-cause":"k9s set image deployment/fred c1=fred:2 i1=busybox:1.32"}},` +
+				`"spec":{"template":{"spec":{"containers":[{"image":"fred:2","name":"c1"}],"initContainers":[{"image":"busybox:1.32","name":"i1"}]}}}}`,
+		},
+		"jobTemplate": {
+			res:  "cronjob/fred",
+			path: jobTemplatePath,
+			ii:   []ContainerImage{{Name: "c1", Image: "fred:2"}},
+			e: `{"metadata":{"annotations":{"kubernetes.io/change-cause":"k9s set image cronjob/fred c1=fred:2"}},` +
+				`"spec":{"jobTemplate":{"spec":{"template":{"spec":{"containers":[{"image":"fred:2","name":"c1"}]}}}}}}`,
+		},
+		"pod": {
+			res:  "pod/fred",
+			path: podSpecPath,
+			ii:   []ContainerImage{{Name: "i1", Image: "busybox:1.32", Init: true}},
+			e: `{"metadata":{"annotations":{"kubernetes.io/change-cause":"k9s set image pod/fred i1=busybox:1.32"}},` +
+				`"spec":{"initContainers":[{"image":"busybox:1.32","name":"i1"}]}}`,
+		},
+		"empty": {
+			res:  "pod/fred",
+			path: podSpecPath,
+			err:  "no container images to update",
+		},
+		"blank": {
+			res:  "pod/fred",
+			path: podSpecPath,
+			ii:   []ContainerImage{{Name: "c1"}},
+			err:  "image for container c1 must not be blank",
+		},
+	}
+
+	for k, u := range uu {
+		t.Run(k, func(t *testing.T) {
+			patch, err := ImagePatch(u.res, u.path, u.ii)
+			if u.err != "" {
+				assert.EqualError(t, err, u.err)
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, u.e, string(patch))
+		})
+	}
+}
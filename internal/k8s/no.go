@@ -1,7 +1,43 @@
 package k8s
 
 import (
+	"fmt"
+	"path"
+	"strings"
+	"sync"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	policyv1beta1 "k8s.io/api/policy/v1beta1"
+	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+const (
+	drainPollInterval   = 2 * time.Second
+	mirrorPodAnnotation = "kubernetes.io/config.mirror"
+)
+
+type (
+	// DrainOptions tunes a node drain.
+	DrainOptions struct {
+		GracePeriodSeconds int
+		Timeout            time.Duration
+		IgnoreDaemonSets   bool
+		DeleteEmptyDirData bool
+		Force              bool
+	}
+
+	// DrainStatus reports the eviction progress of a given pod.
+	DrainStatus struct {
+		Pod    string
+		Status string
+		Err    error
+	}
+
+	// DrainFn receives drain progress notifications.
+	DrainFn func(DrainStatus)
 )
 
 // Node represents a Kubernetes node.
@@ -38,3 +74,182 @@ func (n *Node) List(ns string, opts metav1.ListOptions) (Collection, error) {
 func (n *Node) Delete(_, name string, cascade, force bool) error {
 	return n.DialOrDie().CoreV1().Nodes().Delete(name, nil)
 }
+
+// Cordon marks a node as (un)schedulable.
+func (n *Node) Cordon(name string, cordon bool) error {
+	no, err := n.DialOrDie().CoreV1().Nodes().Get(name, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	if no.Spec.Unschedulable == cordon {
+		return nil
+	}
+
+	patch := fmt.Sprintf(`{"spec":{"unschedulable":%t}}`, cordon)
+	_, err = n.DialOrDie().CoreV1().Nodes().Patch(name, types.StrategicMergePatchType, []byte(patch))
+
+	return err
+}
+
+// Drain cordons a node and evicts all its pods. Evictions honor pod disruption budgets.
+func (n *Node) Drain(name string, opts DrainOptions, fn DrainFn) error {
+	// Check the node can be drained first so it is not left cordoned.
+	if _, err := n.drainablePods(name, opts); err != nil {
+		return err
+	}
+	if err := n.Cordon(name, true); err != nil {
+		return err
+	}
+	evicts, err := n.drainablePods(name, opts)
+	if err != nil {
+		return fmt.Errorf("node %s remains cordoned: %s", name, err)
+	}
+
+	var (
+		wg     sync.WaitGroup
+		mx     sync.Mutex
+		failed int
+	)
+	for _, po := range evicts {
+		wg.Add(1)
+		go func(po v1.Pod) {
+			defer wg.Done()
+			if err := n.evict(po, opts, fn); err != nil {
+				mx.Lock()
+				failed++
+				mx.Unlock()
+			}
+		}(po)
+	}
+	wg.Wait()
+	if failed > 0 {
+		return fmt.Errorf("%d of %d pods could not be evicted from node %s", failed, len(evicts), name)
+	}
+
+	return nil
+}
+
+func (n *Node) drainablePods(name string, opts DrainOptions) ([]v1.Pod, error) {
+	pods, err := n.DialOrDie().CoreV1().Pods("").List(metav1.ListOptions{
+		FieldSelector: "spec.nodeName=" + name,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return DrainablePods(pods.Items, opts)
+}
+
+func (n *Node) evict(po v1.Pod, opts DrainOptions, fn DrainFn) error {
+	var (
+		fqn      = path.Join(po.Namespace, po.Name)
+		deadline = time.Now().Add(opts.Timeout)
+		pods     = n.DialOrDie().CoreV1().Pods(po.Namespace)
+	)
+	expired := func() bool {
+		return opts.Timeout > 0 && time.Now().After(deadline)
+	}
+	fail := func(err error) error {
+		fn(DrainStatus{Pod: fqn, Status: "Failed", Err: err})
+		return err
+	}
+
+	ev := policyv1beta1.Eviction{
+		ObjectMeta:    metav1.ObjectMeta{Namespace: po.Namespace, Name: po.Name},
+		DeleteOptions: &metav1.DeleteOptions{GracePeriodSeconds: gracePeriod(opts.GracePeriodSeconds)},
+	}
+	fn(DrainStatus{Pod: fqn, Status: "Evicting"})
+	for {
+		err := pods.Evict(&ev)
+		if err == nil {
+			break
+		}
+		if errors.IsNotFound(err) {
+			fn(DrainStatus{Pod: fqn, Status: "Evicted"})
+			return nil
+		}
+		if !errors.IsTooManyRequests(err) {
+			return fail(err)
+		}
+		if expired() {
+			return fail(fmt.Errorf("timed out waiting for disruption budget: %v", err))
+		}
+		fn(DrainStatus{Pod: fqn, Status: "Blocked by disruption budget. Retrying..."})
+		time.Sleep(drainPollInterval)
+	}
+
+	fn(DrainStatus{Pod: fqn, Status: "Terminating"})
+	for {
+		p, err := pods.Get(po.Name, metav1.GetOptions{})
+		if errors.IsNotFound(err) || (err == nil && p.UID != po.UID) {
+			fn(DrainStatus{Pod: fqn, Status: "Evicted"})
+			return nil
+		}
+		if err != nil {
+			return fail(err)
+		}
+		if expired() {
+			return fail(fmt.Errorf("timed out waiting for pod termination"))
+		}
+		time.Sleep(drainPollInterval)
+	}
+}
+
+// DrainablePods returns the pods to be evicted from a node. It errors out if
+// some pods can't be evicted given the drain options.
+func DrainablePods(pods []v1.Pod, opts DrainOptions) ([]v1.Pod, error) {
+	var (
+		evicts  []v1.Pod
+		blocked []string
+	)
+	for _, po := range pods {
+		if _, ok := po.Annotations[mirrorPodAnnotation]; ok {
+			continue
+		}
+		if po.Status.Phase == v1.PodSucceeded || po.Status.Phase == v1.PodFailed {
+			evicts = append(evicts, po)
+			continue
+		}
+
+		ref := metav1.GetControllerOf(&po)
+		switch {
+		case ref != nil && ref.Kind == "DaemonSet":
+			if !opts.IgnoreDaemonSets {
+				blocked = append(blocked, path.Join(po.Namespace, po.Name)+" (DaemonSet managed)")
+			}
+			continue
+		case ref == nil && !opts.Force:
+			blocked = append(blocked, path.Join(po.Namespace, po.Name)+" (not managed by a controller)")
+			continue
+		case hasEmptyDir(po) && !opts.DeleteEmptyDirData:
+			blocked = append(blocked, path.Join(po.Namespace, po.Name)+" (uses emptyDir data)")
+			continue
+		}
+		evicts = append(evicts, po)
+	}
+	if len(blocked) > 0 {
+		return nil, fmt.Errorf("unable to drain pods %s", strings.Join(blocked, ", "))
+	}
+
+	return evicts, nil
+}
+
+func hasEmptyDir(po v1.Pod) bool {
+	for _, v := range po.Spec.Volumes {
+		if v.EmptyDir != nil {
+			return true
+		}
+	}
+
+	return false
+}
+
+// GracePeriod returns the pod's own grace period when negative.
+func gracePeriod(secs int) *int64 {
+	if secs < 0 {
+		return nil
+	}
+	g := int64(secs)
+
+	return &g
+}
@@ -0,0 +1,83 @@
+package k8s
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+)
+
+func TestDrainablePods(t *testing.T) {
+	var (
+		rsPod   = runningPod(ownedMeta("p1", "p1", "ReplicaSet", "rs1"), true)
+		dsPod   = runningPod(ownedMeta("p2", "p2", "DaemonSet", "ds1"), true)
+		barePod = runningPod(objMeta("p3", "p3"), true)
+		mirror  = runningPod(objMeta("p4", "p4"), true)
+		done    = runningPod(objMeta("p5", "p5"), true)
+		dirPod  = runningPod(ownedMeta("p6", "p6", "ReplicaSet", "rs1"), true)
+	)
+	mirror.Annotations = map[string]string{mirrorPodAnnotation: "blee"}
+	done.Status.Phase = v1.PodSucceeded
+	dirPod.Spec.Volumes = []v1.Volume{{Name: "tmp", VolumeSource: v1.VolumeSource{EmptyDir: &v1.EmptyDirVolumeSource{}}}}
+
+	uu := map[string]struct {
+		pods []v1.Pod
+		opts DrainOptions
+		e    []string
+		err  string
+	}{
+		"plain": {
+			pods: []v1.Pod{rsPod, mirror, done},
+			e:    []string{"p1", "p5"},
+		},
+		"daemonset": {
+			pods: []v1.Pod{rsPod, dsPod},
+			err:  "unable to drain pods default/p2 (DaemonSet managed)",
+		},
+		"ignoreDaemonSets": {
+			pods: []v1.Pod{rsPod, dsPod},
+			opts: DrainOptions{IgnoreDaemonSets: true},
+			e:    []string{"p1"},
+		},
+		"unmanaged": {
+			pods: []v1.Pod{barePod},
+			err:  "unable to drain pods default/p3 (not managed by a controller)",
+		},
+		"force": {
+			pods: []v1.Pod{barePod},
+			opts: DrainOptions{Force: true},
+			e:    []string{"p3"},
+		},
+		"emptyDir": {
+			pods: []v1.Pod{dirPod, dsPod},
+			err:  "unable to drain pods default/p6 (uses emptyDir data), default/p2 (DaemonSet managed)",
+		},
+		"deleteEmptyDir": {
+			pods: []v1.Pod{dirPod},
+			opts: DrainOptions{DeleteEmptyDirData: true},
+			e:    []string{"p6"},
+		},
+	}
+
+	for k, u := range uu {
+		t.Run(k, func(t *testing.T) {
+			pp, err := DrainablePods(u.pods, u.opts)
+			if u.err != "" {
+				assert.EqualError(t, err, u.err)
+				return
+			}
+			assert.Nil(t, err)
+			nn := make([]string, 0, len(pp))
+			for _, p := range pp {
+				nn = append(nn, p.Name)
+			}
+			assert.Equal(t, u.e, nn)
+		})
+	}
+}
+
+func TestGracePeriod(t *testing.T) {
+	assert.Nil(t, gracePeriod(-1))
+	assert.Equal(t, int64(0), *gracePeriod(0))
+	assert.Equal(t, int64(30), *gracePeriod(30))
+}
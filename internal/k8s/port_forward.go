@@ -6,6 +6,7 @@ import (
 	"net/url"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/rs/zerolog"
@@ -22,7 +23,12 @@ import (
 	"k8s.io/kubectl/pkg/util"
 )
 
-const localhost = "localhost"
+const (
+	localhost         = "localhost"
+	podCheckRate      = 2 * time.Second
+	reconnectDelay    = 1 * time.Second
+	maxReconnectDelay = 30 * time.Second
+)
 
 // PortForward tracks a port forward stream.
 type PortForward struct {
@@ -30,11 +36,18 @@ type PortForward struct {
 	genericclioptions.IOStreams
 
 	stopChan, readyChan chan struct{}
+	doneChan            chan struct{}
 	logger              *zerolog.Logger
+	mx                  sync.RWMutex
 	active              bool
+	stopped             bool
 	path                string
 	container           string
 	ports               []string
+	profile             string
+	selector            string
+	reconnects          int
+	lastErr             error
 	age                 time.Time
 }
 
@@ -45,54 +58,150 @@ func NewPortForward(c Connection, l *zerolog.Logger) *PortForward {
 		logger:     l,
 		stopChan:   make(chan struct{}),
 		readyChan:  make(chan struct{}),
+		doneChan:   make(chan struct{}),
 	}
 }
 
 // Age returns the port forward age.
 func (p *PortForward) Age() string {
+	p.mx.RLock()
+	defer p.mx.RUnlock()
+
 	return time.Since(p.age).String()
 }
 
 // Active returns the forward status.
 func (p *PortForward) Active() bool {
+	p.mx.RLock()
+	defer p.mx.RUnlock()
+
 	return p.active
 }
 
 // SetActive mark a portforward as active.
 func (p *PortForward) SetActive(b bool) {
+	p.mx.Lock()
+	defer p.mx.Unlock()
+
 	p.active = b
 }
 
 // Ports returns the forwarded ports mappings.
 func (p *PortForward) Ports() []string {
+	p.mx.RLock()
+	defer p.mx.RUnlock()
+
 	return p.ports
 }
 
 // Path returns the pod resource path.
 func (p *PortForward) Path() string {
+	p.mx.RLock()
+	defer p.mx.RUnlock()
+
 	return p.path
 }
 
 // Container returns the targetes container.
 func (p *PortForward) Container() string {
+	p.mx.RLock()
+	defer p.mx.RUnlock()
+
 	return p.container
 }
 
+// Profile returns the port forward definition name if any.
+func (p *PortForward) Profile() string {
+	p.mx.RLock()
+	defer p.mx.RUnlock()
+
+	return p.profile
+}
+
+// SetProfile tags the port forward with a definition name.
+func (p *PortForward) SetProfile(n string) {
+	p.mx.Lock()
+	defer p.mx.Unlock()
+
+	p.profile = n
+}
+
+// Selector returns the owner selector used to pick a new pod on reconnect.
+func (p *PortForward) Selector() string {
+	p.mx.RLock()
+	defer p.mx.RUnlock()
+
+	return p.selector
+}
+
+// SetSelector sets the owner selector. When set, the port forward follows
+// the pods matching the selector.
+func (p *PortForward) SetSelector(sel string) {
+	p.mx.Lock()
+	defer p.mx.Unlock()
+
+	p.selector = sel
+}
+
+// Reconnects returns the number of times the port forward was reestablished.
+func (p *PortForward) Reconnects() int {
+	p.mx.RLock()
+	defer p.mx.RUnlock()
+
+	return p.reconnects
+}
+
+// LastError returns the last port forward error if any.
+func (p *PortForward) LastError() string {
+	p.mx.RLock()
+	defer p.mx.RUnlock()
+
+	if p.lastErr == nil {
+		return ""
+	}
+	return p.lastErr.Error()
+}
+
+// Stopped checks if the port forward was terminated.
+func (p *PortForward) Stopped() bool {
+	p.mx.RLock()
+	defer p.mx.RUnlock()
+
+	return p.stopped
+}
+
 // Stop terminates a port forard
 func (p *PortForward) Stop() {
+	p.mx.Lock()
+	defer p.mx.Unlock()
+
 	p.logger.Debug().Msgf("<<< Stopping port forward %q %v", p.path, p.ports)
-	p.active = false
-	close(p.stopChan)
+	if p.stopped {
+		return
+	}
+	p.active, p.stopped = false, true
+	close(p.doneChan)
+	p.closeSession()
 }
 
 // FQN returns the portforward unique id.
 func (p *PortForward) FQN() string {
+	p.mx.RLock()
+	defer p.mx.RUnlock()
+
 	return p.path + ":" + p.container
 }
 
 // Start initiates a port forward session for a given pod and ports.
 func (p *PortForward) Start(path, co string, ports []string) (*portforward.PortForwarder, error) {
-	p.path, p.container, p.ports, p.age = path, co, ports, time.Now()
+	p.mx.Lock()
+	{
+		p.path, p.container, p.ports = path, co, ports
+		if p.age.IsZero() {
+			p.age = time.Now()
+		}
+	}
+	p.mx.Unlock()
 
 	ns, n := namespaced(path)
 	pod, err := p.DialOrDie().CoreV1().Pods(ns).Get(n, metav1.GetOptions{})
@@ -134,12 +243,206 @@ func (p *PortForward) forwardPorts(method string, url *url.URL, ports []string)
 
 	dialer := spdy.NewDialer(upgrader, &http.Client{Transport: transport}, method, url)
 	addrs := []string{localhost}
+	p.mx.RLock()
+	defer p.mx.RUnlock()
+
 	return portforward.NewOnAddresses(dialer, addrs, ports, p.stopChan, p.readyChan, p.Out, p.ErrOut)
 }
 
+// Run forwards ports until the port forward is stopped. When an owner selector
+// is set, the port forward reconnects to a new ready pod once the current pod
+// goes away.
+func (p *PortForward) Run(f *portforward.PortForwarder) error {
+	for {
+		p.SetActive(true)
+		done := make(chan struct{})
+		if p.Selector() != "" {
+			go p.watchPod(done)
+		}
+		err := f.ForwardPorts()
+		close(done)
+		p.SetActive(false)
+
+		if p.Stopped() || p.Selector() == "" || !p.ready() {
+			return err
+		}
+		if err == nil {
+			err = fmt.Errorf("lost connection to pod %s", p.Path())
+		}
+		p.setLastError(err)
+		if f, err = p.reconnect(); err != nil {
+			return err
+		}
+	}
+}
+
+func (p *PortForward) watchPod(done <-chan struct{}) {
+	ns, n := namespaced(p.Path())
+	for {
+		select {
+		case <-done:
+			return
+		case <-time.After(podCheckRate):
+		}
+
+		pod, err := p.DialOrDie().CoreV1().Pods(ns).Get(n, metav1.GetOptions{})
+		if err == nil && isPodReady(*pod) {
+			continue
+		}
+		if err == nil {
+			err = fmt.Errorf("pod %s/%s is no longer ready", ns, n)
+		}
+		p.logger.Debug().Msgf("Port forward %s lost its pod: %v", p.FQN(), err)
+		p.setLastError(err)
+		p.mx.Lock()
+		p.closeSession()
+		p.mx.Unlock()
+		return
+	}
+}
+
+func (p *PortForward) reconnect() (*portforward.PortForwarder, error) {
+	ns, _ := namespaced(p.Path())
+	delay := reconnectDelay
+	for {
+		select {
+		case <-p.doneChan:
+			return nil, fmt.Errorf("port forward %s stopped", p.FQN())
+		case <-time.After(delay):
+		}
+
+		po, err := FindReadyPod(p.Connection, ns, p.Selector())
+		if err == nil {
+			if err = p.newSession(); err != nil {
+				return nil, err
+			}
+			var f *portforward.PortForwarder
+			if f, err = p.Start(po.Namespace+"/"+po.Name, p.Container(), p.Ports()); err == nil {
+				p.mx.Lock()
+				p.reconnects++
+				p.mx.Unlock()
+				p.logger.Debug().Msgf(">>> Port forward reconnected %s", p.FQN())
+				return f, nil
+			}
+		}
+		p.setLastError(err)
+		if delay *= 2; delay > maxReconnectDelay {
+			delay = maxReconnectDelay
+		}
+	}
+}
+
+func (p *PortForward) ready() bool {
+	p.mx.RLock()
+	defer p.mx.RUnlock()
+
+	select {
+	case <-p.readyChan:
+		return true
+	default:
+		return false
+	}
+}
+
+func (p *PortForward) setLastError(err error) {
+	p.mx.Lock()
+	defer p.mx.Unlock()
+
+	p.lastErr = err
+}
+
+func (p *PortForward) newSession() error {
+	p.mx.Lock()
+	defer p.mx.Unlock()
+
+	if p.stopped {
+		return fmt.Errorf("port forward %s stopped", p.path)
+	}
+	p.stopChan, p.readyChan = make(chan struct{}), make(chan struct{})
+
+	return nil
+}
+
+// CloseSession terminates the current forwarding session. Caller must hold the lock.
+func (p *PortForward) closeSession() {
+	select {
+	case <-p.stopChan:
+	default:
+		close(p.stopChan)
+	}
+}
+
+// FindReadyPod returns a ready pod matching the given selector.
+func FindReadyPod(c Connection, ns, sel string) (*v1.Pod, error) {
+	pods, err := c.DialOrDie().CoreV1().Pods(ns).List(metav1.ListOptions{LabelSelector: sel})
+	if err != nil {
+		return nil, err
+	}
+	po, ok := readyPod(pods.Items)
+	if !ok {
+		return nil, fmt.Errorf("no ready pods found in %q matching %q", ns, sel)
+	}
+
+	return po, nil
+}
+
+// TargetPorts translates local:remote port mappings into pod container ports.
+// When a service is given, remote ports refer to the service ports otherwise
+// remote ports may be container port names or numbers.
+func TargetPorts(svc *v1.Service, pod v1.Pod, ports []string) ([]string, error) {
+	if svc != nil {
+		return svcPortToTargetPort(ports, *svc, pod)
+	}
+
+	return podPortToTargetPort(ports, pod)
+}
+
+// PortContainer returns the name of the container exposing the remote port of
+// the given port mapping. Defaults to the first container.
+func PortContainer(pod v1.Pod, port string) string {
+	if len(pod.Spec.Containers) == 0 {
+		return ""
+	}
+	_, remote := splitPort(port)
+	if n, err := strconv.Atoi(remote); err == nil {
+		for _, co := range pod.Spec.Containers {
+			for _, p := range co.Ports {
+				if p.ContainerPort == int32(n) {
+					return co.Name
+				}
+			}
+		}
+	}
+
+	return pod.Spec.Containers[0].Name
+}
+
 // ----------------------------------------------------------------------------
 // Helpers...
 
+func readyPod(pods []v1.Pod) (*v1.Pod, bool) {
+	for i := range pods {
+		if isPodReady(pods[i]) {
+			return &pods[i], true
+		}
+	}
+
+	return nil, false
+}
+
+func isPodReady(po v1.Pod) bool {
+	if po.DeletionTimestamp != nil || po.Status.Phase != v1.PodRunning {
+		return false
+	}
+	for _, c := range po.Status.Conditions {
+		if c.Type == v1.PodReady {
+			return c.Status == v1.ConditionTrue
+		}
+	}
+
+	return false
+}
+
 func codec() (serializer.CodecFactory, runtime.ParameterCodec) {
 	scheme := runtime.NewScheme()
 	gv := schema.GroupVersion{Group: "", Version: "v1"}
@@ -178,6 +481,27 @@ func svcPortToTargetPort(ports []string, svc v1.Service, pod v1.Pod) ([]string,
 	return translated, nil
 }
 
+func podPortToTargetPort(ports []string, pod v1.Pod) ([]string, error) {
+	translated := make([]string, 0, len(ports))
+	for _, port := range ports {
+		localPort, remotePort := splitPort(port)
+		if _, err := strconv.Atoi(remotePort); err == nil {
+			translated = append(translated, port)
+			continue
+		}
+		containerPort, err := util.LookupContainerPortNumberByName(pod, remotePort)
+		if err != nil {
+			return nil, err
+		}
+		if localPort == remotePort {
+			localPort = strconv.Itoa(int(containerPort))
+		}
+		translated = append(translated, fmt.Sprintf("%s:%d", localPort, containerPort))
+	}
+
+	return translated, nil
+}
+
 func splitPort(port string) (local, remote string) {
 	parts := strings.Split(port, ":")
 	if len(parts) == 2 {
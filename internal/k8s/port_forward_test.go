@@ -0,0 +1,128 @@
+package k8s
+
+import (
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+func TestReadyPod(t *testing.T) {
+	now := metav1.Now()
+	uu := map[string]struct {
+		pods []v1.Pod
+		e    string
+		ok   bool
+	}{
+		"none": {},
+		"pending": {
+			pods: []v1.Pod{makePod("p1", v1.PodPending, v1.ConditionFalse, nil)},
+		},
+		"notReady": {
+			pods: []v1.Pod{makePod("p1", v1.PodRunning, v1.ConditionFalse, nil)},
+		},
+		"terminating": {
+			pods: []v1.Pod{makePod("p1", v1.PodRunning, v1.ConditionTrue, &now)},
+		},
+		"ready": {
+			pods: []v1.Pod{
+				makePod("p1", v1.PodRunning, v1.ConditionFalse, nil),
+				makePod("p2", v1.PodRunning, v1.ConditionTrue, nil),
+				makePod("p3", v1.PodRunning, v1.ConditionTrue, nil),
+			},
+			e:  "p2",
+			ok: true,
+		},
+	}
+
+	for k, u := range uu {
+		t.Run(k, func(t *testing.T) {
+			po, ok := readyPod(u.pods)
+			assert.Equal(t, u.ok, ok)
+			if ok {
+				assert.Equal(t, u.e, po.Name)
+			}
+		})
+	}
+}
+
+// Helpers...
+
+func makePod(n string, phase v1.PodPhase, ready v1.ConditionStatus, del *metav1.Time) v1.Pod {
+	return v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: n, Namespace: "default", DeletionTimestamp: del},
+		Status: v1.PodStatus{
+			Phase: phase,
+			Conditions: []v1.PodCondition{
+				{Type: v1.PodReady, Status: ready},
+			},
+		},
+	}
+}
+
+func TestPortForwardStop(t *testing.T) {
+	l := zerolog.Nop()
+	p := NewPortForward(nil, &l)
+	p.SetSelector("app=fred")
+
+	assert.Nil(t, p.newSession())
+	p.Stop()
+	p.Stop()
+
+	assert.True(t, p.Stopped())
+	assert.False(t, p.Active())
+	assert.NotNil(t, p.newSession())
+	_, err := p.reconnect()
+	assert.NotNil(t, err)
+}
+
+func TestTargetPorts(t *testing.T) {
+	po := v1.Pod{
+		Spec: v1.PodSpec{
+			Containers: []v1.Container{
+				{Name: "sidecar", Ports: []v1.ContainerPort{{Name: "metrics", ContainerPort: 9090}}},
+				{Name: "web", Ports: []v1.ContainerPort{{Name: "http", ContainerPort: 8080}}},
+			},
+		},
+	}
+	svc := v1.Service{
+		Spec: v1.ServiceSpec{
+			Ports: []v1.ServicePort{
+				{Name: "web", Port: 80, TargetPort: intstr.FromString("http")},
+				{Name: "prom", Port: 90, TargetPort: intstr.FromInt(9090)},
+			},
+		},
+	}
+
+	uu := map[string]struct {
+		svc   *v1.Service
+		ports []string
+		e     []string
+		co    string
+		err   bool
+	}{
+		"podNum":     {ports: []string{"3000:8080"}, e: []string{"3000:8080"}, co: "web"},
+		"podNamed":   {ports: []string{"http"}, e: []string{"8080:8080"}, co: "web"},
+		"podLocal":   {ports: []string{"3000:metrics"}, e: []string{"3000:9090"}, co: "sidecar"},
+		"podBusted":  {ports: []string{"3000:blee"}, err: true},
+		"svcNum":     {svc: &svc, ports: []string{"3000:80"}, e: []string{"3000:8080"}, co: "web"},
+		"svcNamed":   {svc: &svc, ports: []string{"prom"}, e: []string{"90:9090"}, co: "sidecar"},
+		"svcUnknown": {svc: &svc, ports: []string{"3000:blee"}, err: true},
+	}
+
+	for k, u := range uu {
+		t.Run(k, func(t *testing.T) {
+			pp, err := TargetPorts(u.svc, po, u.ports)
+			if u.err {
+				assert.NotNil(t, err)
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, u.e, pp)
+			assert.Equal(t, u.co, PortContainer(po, pp[0]))
+		})
+	}
+}
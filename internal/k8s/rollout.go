@@ -0,0 +1,374 @@
+package k8s
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/kubectl/pkg/polymorphichelpers"
+)
+
+const (
+	// ChangeCauseAnnotation records the reason of a rollout.
+	ChangeCauseAnnotation = "kubernetes.io/change-cause"
+
+	revisionAnnotation = "deployment.kubernetes.io/revision"
+	timedOutReason     = "ProgressDeadlineExceeded"
+	podTemplateHashKey = "pod-template-hash"
+)
+
+// Revision represents a rollout revision of a workload.
+type Revision struct {
+	Number      int64
+	Name        string
+	ChangeCause string
+	Created     metav1.Time
+	Template    v1.PodTemplateSpec
+	Current     bool
+}
+
+// History returns a Deployment rollout history.
+func (d *Deployment) History(ns, n string) ([]Revision, error) {
+	dp, err := d.DialOrDie().AppsV1().Deployments(ns).Get(n, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	sel, err := metav1.LabelSelectorAsSelector(dp.Spec.Selector)
+	if err != nil {
+		return nil, err
+	}
+	rss, err := d.DialOrDie().AppsV1().ReplicaSets(ns).List(metav1.ListOptions{LabelSelector: sel.String()})
+	if err != nil {
+		return nil, err
+	}
+
+	return DeploymentRevisions(dp, rss.Items), nil
+}
+
+// Rollback a Deployment to a given revision.
+func (d *Deployment) Rollback(ns, n string, rev int64) error {
+	dp, err := d.DialOrDie().AppsV1().Deployments(ns).Get(n, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+
+	return rollback(d, schema.GroupKind{Group: appsv1.GroupName, Kind: "Deployment"}, dp, rev)
+}
+
+// Pause a Deployment rollout.
+func (d *Deployment) Pause(ns, n string) error {
+	return d.setPaused(ns, n, true)
+}
+
+// Resume a paused Deployment rollout.
+func (d *Deployment) Resume(ns, n string) error {
+	return d.setPaused(ns, n, false)
+}
+
+// IsPaused checks if a Deployment rollout is paused.
+func (d *Deployment) IsPaused(ns, n string) (bool, error) {
+	dp, err := d.DialOrDie().AppsV1().Deployments(ns).Get(n, metav1.GetOptions{})
+	if err != nil {
+		return false, err
+	}
+
+	return dp.Spec.Paused, nil
+}
+
+func (d *Deployment) setPaused(ns, n string, paused bool) error {
+	patch := fmt.Sprintf(`{"spec":{"paused":%t}}`, paused)
+	_, err := d.DialOrDie().AppsV1().Deployments(ns).Patch(n, types.StrategicMergePatchType, []byte(patch))
+
+	return err
+}
+
+// History returns a StatefulSet rollout history.
+func (s *StatefulSet) History(ns, n string) ([]Revision, error) {
+	sts, err := s.DialOrDie().AppsV1().StatefulSets(ns).Get(n, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	crs, err := controllerRevisions(s, ns, sts.Spec.Selector)
+	if err != nil {
+		return nil, err
+	}
+
+	return ControllerRevisions(sts, crs)
+}
+
+// Rollback a StatefulSet to a given revision.
+func (s *StatefulSet) Rollback(ns, n string, rev int64) error {
+	sts, err := s.DialOrDie().AppsV1().StatefulSets(ns).Get(n, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+
+	return rollback(s, schema.GroupKind{Group: appsv1.GroupName, Kind: "StatefulSet"}, sts, rev)
+}
+
+// History returns a DaemonSet rollout history.
+func (d *DaemonSet) History(ns, n string) ([]Revision, error) {
+	ds, err := d.DialOrDie().AppsV1().DaemonSets(ns).Get(n, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	crs, err := controllerRevisions(d, ns, ds.Spec.Selector)
+	if err != nil {
+		return nil, err
+	}
+
+	return ControllerRevisions(ds, crs)
+}
+
+// Rollback a DaemonSet to a given revision.
+func (d *DaemonSet) Rollback(ns, n string, rev int64) error {
+	ds, err := d.DialOrDie().AppsV1().DaemonSets(ns).Get(n, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+
+	return rollback(d, schema.GroupKind{Group: appsv1.GroupName, Kind: "DaemonSet"}, ds, rev)
+}
+
+// DeploymentRevisions returns the revisions of a Deployment from its ReplicaSets.
+func DeploymentRevisions(dp *appsv1.Deployment, rss []appsv1.ReplicaSet) []Revision {
+	current := dp.Annotations[revisionAnnotation]
+	rr := make([]Revision, 0, len(rss))
+	for i := range rss {
+		rs := rss[i]
+		if !metav1.IsControlledBy(&rs, dp) {
+			continue
+		}
+		rev, err := strconv.ParseInt(rs.Annotations[revisionAnnotation], 10, 64)
+		if err != nil {
+			continue
+		}
+		tpl := *rs.Spec.Template.DeepCopy()
+		delete(tpl.Labels, podTemplateHashKey)
+		rr = append(rr, Revision{
+			Number:      rev,
+			Name:        rs.Name,
+			ChangeCause: rs.Annotations[ChangeCauseAnnotation],
+			Created:     rs.CreationTimestamp,
+			Template:    tpl,
+			Current:     rs.Annotations[revisionAnnotation] == current,
+		})
+	}
+	sortRevisions(rr)
+
+	return rr
+}
+
+// ControllerRevisions returns the revisions of a StatefulSet or DaemonSet.
+func ControllerRevisions(owner metav1.Object, crs []appsv1.ControllerRevision) ([]Revision, error) {
+	rr := make([]Revision, 0, len(crs))
+	for i := range crs {
+		cr := crs[i]
+		if !metav1.IsControlledBy(&cr, owner) {
+			continue
+		}
+		tpl, err := revisionTemplate(cr.Data)
+		if err != nil {
+			return nil, fmt.Errorf("unable to decode revision %s: %v", cr.Name, err)
+		}
+		rr = append(rr, Revision{
+			Number:      cr.Revision,
+			Name:        cr.Name,
+			ChangeCause: cr.Annotations[ChangeCauseAnnotation],
+			Created:     cr.CreationTimestamp,
+			Template:    tpl,
+		})
+	}
+	sortRevisions(rr)
+	if len(rr) > 0 {
+		rr[len(rr)-1].Current = true
+	}
+
+	return rr, nil
+}
+
+// RolloutStatus tracks the progress of a workload rollout.
+type RolloutStatus struct {
+	Desired   int32
+	Updated   int32
+	Ready     int32
+	Available int32
+	Old       int32
+	Deadline  time.Time
+	Message   string
+	Reason    string
+	Done      bool
+	Failed    bool
+	Paused    bool
+}
+
+// DeploymentRolloutStatus computes a Deployment rollout progress.
+func DeploymentRolloutStatus(dp *appsv1.Deployment) RolloutStatus {
+	st := dp.Status
+	s := RolloutStatus{
+		Desired:   replicas(dp.Spec.Replicas),
+		Updated:   st.UpdatedReplicas,
+		Ready:     st.ReadyReplicas,
+		Available: st.AvailableReplicas,
+		Old:       oldReplicas(st.Replicas, st.UpdatedReplicas),
+	}
+	for _, c := range st.Conditions {
+		switch {
+		case c.Type == appsv1.DeploymentProgressing:
+			if pds := dp.Spec.ProgressDeadlineSeconds; pds != nil {
+				s.Deadline = c.LastUpdateTime.Add(time.Duration(*pds) * time.Second)
+			}
+			if c.Reason == timedOutReason {
+				s.Reason = c.Reason + ": " + c.Message
+			}
+		case c.Type == appsv1.DeploymentReplicaFailure && c.Status == v1.ConditionTrue:
+			s.Reason = c.Reason + ": " + c.Message
+		}
+	}
+
+	switch {
+	case dp.Spec.Paused:
+		s.Message, s.Paused = "Deployment rollout is paused", true
+	case dp.Generation > st.ObservedGeneration:
+		s.Message = "Waiting for deployment spec update to be observed..."
+	case strings.HasPrefix(s.Reason, timedOutReason):
+		s.Message, s.Failed = "Deployment exceeded its progress deadline", true
+	case s.Updated < s.Desired:
+		s.Message = fmt.Sprintf("Waiting for rollout to finish: %d out of %d new replicas have been updated...", s.Updated, s.Desired)
+	case s.Old > 0:
+		s.Message = fmt.Sprintf("Waiting for rollout to finish: %d old replicas are pending termination...", s.Old)
+	case s.Available < s.Updated:
+		s.Message = fmt.Sprintf("Waiting for rollout to finish: %d of %d updated replicas are available...", s.Available, s.Updated)
+	default:
+		s.Message, s.Done = "Successfully rolled out", true
+	}
+
+	return s
+}
+
+// StatefulSetRolloutStatus computes a StatefulSet rollout progress.
+func StatefulSetRolloutStatus(sts *appsv1.StatefulSet) RolloutStatus {
+	st := sts.Status
+	s := RolloutStatus{
+		Desired:   replicas(sts.Spec.Replicas),
+		Updated:   st.UpdatedReplicas,
+		Ready:     st.ReadyReplicas,
+		Available: st.ReadyReplicas,
+	}
+	if st.UpdateRevision != st.CurrentRevision {
+		s.Old = st.CurrentReplicas
+	}
+
+	var partition int32
+	if ru := sts.Spec.UpdateStrategy.RollingUpdate; ru != nil && ru.Partition != nil {
+		partition = *ru.Partition
+	}
+	switch {
+	case sts.Spec.UpdateStrategy.Type != appsv1.RollingUpdateStatefulSetStrategyType:
+		s.Message, s.Done = "Rollout status is only available for RollingUpdate strategy", true
+	case st.ObservedGeneration == 0 || sts.Generation > st.ObservedGeneration:
+		s.Message = "Waiting for statefulset spec update to be observed..."
+	case s.Ready < s.Desired:
+		s.Message = fmt.Sprintf("Waiting for %d pods to be ready...", s.Desired-s.Ready)
+	case partition > 0 && s.Updated < s.Desired-partition:
+		s.Message = fmt.Sprintf("Waiting for partitioned rollout to finish: %d out of %d new pods have been updated...", s.Updated, s.Desired-partition)
+	case partition > 0:
+		s.Message, s.Done = fmt.Sprintf("Partitioned rollout complete: %d new pods have been updated", s.Updated), true
+	case st.UpdateRevision != st.CurrentRevision:
+		s.Message = fmt.Sprintf("Waiting for rolling update to complete: %d pods at revision %s...", s.Updated, st.UpdateRevision)
+	default:
+		s.Message, s.Done = "Successfully rolled out", true
+	}
+
+	return s
+}
+
+// DaemonSetRolloutStatus computes a DaemonSet rollout progress.
+func DaemonSetRolloutStatus(ds *appsv1.DaemonSet) RolloutStatus {
+	st := ds.Status
+	s := RolloutStatus{
+		Desired:   st.DesiredNumberScheduled,
+		Updated:   st.UpdatedNumberScheduled,
+		Ready:     st.NumberReady,
+		Available: st.NumberAvailable,
+		Old:       oldReplicas(st.CurrentNumberScheduled, st.UpdatedNumberScheduled),
+	}
+
+	switch {
+	case ds.Spec.UpdateStrategy.Type != appsv1.RollingUpdateDaemonSetStrategyType:
+		s.Message, s.Done = "Rollout status is only available for RollingUpdate strategy", true
+	case ds.Generation > st.ObservedGeneration:
+		s.Message = "Waiting for daemon set spec update to be observed..."
+	case s.Updated < s.Desired:
+		s.Message = fmt.Sprintf("Waiting for rollout to finish: %d out of %d new pods have been updated...", s.Updated, s.Desired)
+	case s.Available < s.Desired:
+		s.Message = fmt.Sprintf("Waiting for rollout to finish: %d of %d updated pods are available...", s.Available, s.Desired)
+	default:
+		s.Message, s.Done = "Successfully rolled out", true
+	}
+
+	return s
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func controllerRevisions(c Connection, ns string, s *metav1.LabelSelector) ([]appsv1.ControllerRevision, error) {
+	sel, err := metav1.LabelSelectorAsSelector(s)
+	if err != nil {
+		return nil, err
+	}
+	ll, err := c.DialOrDie().AppsV1().ControllerRevisions(ns).List(metav1.ListOptions{LabelSelector: sel.String()})
+	if err != nil {
+		return nil, err
+	}
+
+	return ll.Items, nil
+}
+
+func rollback(c Connection, gk schema.GroupKind, o runtime.Object, rev int64) error {
+	r, err := polymorphichelpers.RollbackerFor(gk, c.DialOrDie())
+	if err != nil {
+		return err
+	}
+	_, err = r.Rollback(o, nil, rev, false)
+
+	return err
+}
+
+// RevisionTemplate extracts the pod template from a controller revision patch.
+func revisionTemplate(data runtime.RawExtension) (v1.PodTemplateSpec, error) {
+	var patch struct {
+		Spec struct {
+			Template v1.PodTemplateSpec `json:"template"`
+		} `json:"spec"`
+	}
+	if err := json.Unmarshal(data.Raw, &patch); err != nil {
+		return v1.PodTemplateSpec{}, err
+	}
+
+	return patch.Spec.Template, nil
+}
+
+func sortRevisions(rr []Revision) {
+	sort.Slice(rr, func(i, j int) bool {
+		return rr[i].Number < rr[j].Number
+	})
+}
+
+func oldReplicas(total, updated int32) int32 {
+	if total > updated {
+		return total - updated
+	}
+
+	return 0
+}
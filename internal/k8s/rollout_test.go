@@ -0,0 +1,256 @@
+package k8s
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestDeploymentRevisions(t *testing.T) {
+	dp := appsv1.Deployment{ObjectMeta: objMeta("dp1", "dp1")}
+	dp.Annotations = map[string]string{revisionAnnotation: "3"}
+
+	rss := []appsv1.ReplicaSet{
+		revisionRS("rs3", "3", "set image fred:2", "fred:2"),
+		revisionRS("rs1", "1", "", "fred:1"),
+		revisionRS("rsx", "x", "", "fred:0"),
+		{ObjectMeta: objMeta("rs9", "rs9")},
+	}
+
+	rr := DeploymentRevisions(&dp, rss)
+	assert.Equal(t, 2, len(rr))
+	assert.Equal(t, int64(1), rr[0].Number)
+	assert.Equal(t, "rs1", rr[0].Name)
+	assert.False(t, rr[0].Current)
+	assert.Equal(t, int64(3), rr[1].Number)
+	assert.Equal(t, "set image fred:2", rr[1].ChangeCause)
+	assert.Equal(t, "fred:2", rr[1].Template.Spec.Containers[0].Image)
+	assert.Equal(t, map[string]string{"app": "fred"}, rr[1].Template.Labels)
+	assert.True(t, rr[1].Current)
+	assert.Equal(t, "abc", rss[0].Spec.Template.Labels[podTemplateHashKey])
+}
+
+func TestControllerRevisions(t *testing.T) {
+	sts := appsv1.StatefulSet{ObjectMeta: objMeta("sts1", "sts1")}
+	crs := []appsv1.ControllerRevision{
+		{
+			ObjectMeta: ownedMeta("cr2", "cr2", "StatefulSet", "sts1"),
+			Revision:   2,
+			Data:       runtime.RawExtension{Raw: []byte(`{"spec":{"template":{"$patch":"replace","spec":{"containers":[{"name":"c1","image":"fred:2"}]}}}}`)},
+		},
+		{
+			ObjectMeta: ownedMeta("cr1", "cr1", "StatefulSet", "sts1"),
+			Revision:   1,
+			Data:       runtime.RawExtension{Raw: []byte(`{"spec":{"template":{"spec":{"containers":[{"name":"c1","image":"fred:1"}]}}}}`)},
+		},
+		{
+			ObjectMeta: ownedMeta("cr3", "cr3", "StatefulSet", "sts2"),
+			Revision:   3,
+		},
+	}
+	crs[0].Annotations = map[string]string{ChangeCauseAnnotation: "bumped"}
+
+	rr, err := ControllerRevisions(&sts, crs)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(rr))
+	assert.Equal(t, "cr1", rr[0].Name)
+	assert.Equal(t, "fred:1", rr[0].Template.Spec.Containers[0].Image)
+	assert.False(t, rr[0].Current)
+	assert.Equal(t, "cr2", rr[1].Name)
+	assert.Equal(t, "bumped", rr[1].ChangeCause)
+	assert.True(t, rr[1].Current)
+
+	crs[1].Data.Raw = []byte("{")
+	_, err = ControllerRevisions(&sts, crs)
+	assert.NotNil(t, err)
+}
+
+func TestDeploymentRolloutStatus(t *testing.T) {
+	now := metav1.NewTime(time.Date(2019, 10, 1, 0, 0, 0, 0, time.UTC))
+	progressing := appsv1.DeploymentCondition{Type: appsv1.DeploymentProgressing, LastUpdateTime: now}
+	timedOut := progressing
+	timedOut.Reason, timedOut.Message = timedOutReason, "rs fred has timed out"
+	quota := appsv1.DeploymentCondition{Type: appsv1.DeploymentReplicaFailure, Status: v1.ConditionTrue, Reason: "FailedCreate", Message: "exceeded quota"}
+
+	uu := map[string]struct {
+		generation int64
+		paused     bool
+		status     appsv1.DeploymentStatus
+		e          RolloutStatus
+	}{
+		"paused": {
+			generation: 2,
+			paused:     true,
+			status:     appsv1.DeploymentStatus{ObservedGeneration: 1, Replicas: 3, UpdatedReplicas: 1},
+			e:          RolloutStatus{Desired: 3, Updated: 1, Old: 2, Message: "Deployment rollout is paused", Paused: true},
+		},
+		"observing": {
+			generation: 2,
+			status:     appsv1.DeploymentStatus{ObservedGeneration: 1},
+			e:          RolloutStatus{Desired: 3, Message: "Waiting for deployment spec update to be observed..."},
+		},
+		"updating": {
+			status: appsv1.DeploymentStatus{Replicas: 4, UpdatedReplicas: 1, Conditions: []appsv1.DeploymentCondition{progressing, quota}},
+			e: RolloutStatus{
+				Desired:  3,
+				Updated:  1,
+				Old:      3,
+				Deadline: now.Add(10 * time.Minute),
+				Reason:   "FailedCreate: exceeded quota",
+				Message:  "Waiting for rollout to finish: 1 out of 3 new replicas have been updated...",
+			},
+		},
+		"terminating": {
+			status: appsv1.DeploymentStatus{Replicas: 4, UpdatedReplicas: 3, ReadyReplicas: 3, AvailableReplicas: 3},
+			e:      RolloutStatus{Desired: 3, Updated: 3, Ready: 3, Available: 3, Old: 1, Message: "Waiting for rollout to finish: 1 old replicas are pending termination..."},
+		},
+		"available": {
+			status: appsv1.DeploymentStatus{Replicas: 3, UpdatedReplicas: 3, ReadyReplicas: 3, AvailableReplicas: 2},
+			e:      RolloutStatus{Desired: 3, Updated: 3, Ready: 3, Available: 2, Message: "Waiting for rollout to finish: 2 of 3 updated replicas are available..."},
+		},
+		"done": {
+			status: appsv1.DeploymentStatus{Replicas: 3, UpdatedReplicas: 3, ReadyReplicas: 3, AvailableReplicas: 3},
+			e:      RolloutStatus{Desired: 3, Updated: 3, Ready: 3, Available: 3, Message: "Successfully rolled out", Done: true},
+		},
+		"failed": {
+			status: appsv1.DeploymentStatus{Replicas: 3, UpdatedReplicas: 1, Conditions: []appsv1.DeploymentCondition{timedOut}},
+			e: RolloutStatus{
+				Desired:  3,
+				Updated:  1,
+				Old:      2,
+				Deadline: now.Add(10 * time.Minute),
+				Reason:   "ProgressDeadlineExceeded: rs fred has timed out",
+				Message:  "Deployment exceeded its progress deadline",
+				Failed:   true,
+			},
+		},
+	}
+
+	for k, u := range uu {
+		t.Run(k, func(t *testing.T) {
+			dp := appsv1.Deployment{ObjectMeta: objMeta("dp1", "dp1"), Status: u.status}
+			dp.Generation, dp.Spec.Paused = u.generation, u.paused
+			dp.Spec.Replicas, dp.Spec.ProgressDeadlineSeconds = int32Ptr(3), int32Ptr(600)
+			assert.Equal(t, u.e, DeploymentRolloutStatus(&dp))
+		})
+	}
+}
+
+func TestStatefulSetRolloutStatus(t *testing.T) {
+	uu := map[string]struct {
+		strategy appsv1.StatefulSetUpdateStrategy
+		status   appsv1.StatefulSetStatus
+		e        RolloutStatus
+	}{
+		"onDelete": {
+			strategy: appsv1.StatefulSetUpdateStrategy{Type: appsv1.OnDeleteStatefulSetStrategyType},
+			e:        RolloutStatus{Desired: 3, Message: "Rollout status is only available for RollingUpdate strategy", Done: true},
+		},
+		"observing": {
+			e: RolloutStatus{Desired: 3, Message: "Waiting for statefulset spec update to be observed..."},
+		},
+		"ready": {
+			status: appsv1.StatefulSetStatus{ObservedGeneration: 1, ReadyReplicas: 1, CurrentReplicas: 2, UpdatedReplicas: 1, CurrentRevision: "r1", UpdateRevision: "r2"},
+			e:      RolloutStatus{Desired: 3, Updated: 1, Ready: 1, Available: 1, Old: 2, Message: "Waiting for 2 pods to be ready..."},
+		},
+		"partitioned": {
+			strategy: partitioned(1),
+			status:   appsv1.StatefulSetStatus{ObservedGeneration: 1, ReadyReplicas: 3, CurrentReplicas: 2, UpdatedReplicas: 1, CurrentRevision: "r1", UpdateRevision: "r2"},
+			e:        RolloutStatus{Desired: 3, Updated: 1, Ready: 3, Available: 3, Old: 2, Message: "Waiting for partitioned rollout to finish: 1 out of 2 new pods have been updated..."},
+		},
+		"partitionDone": {
+			strategy: partitioned(1),
+			status:   appsv1.StatefulSetStatus{ObservedGeneration: 1, ReadyReplicas: 3, CurrentReplicas: 1, UpdatedReplicas: 2, CurrentRevision: "r1", UpdateRevision: "r2"},
+			e:        RolloutStatus{Desired: 3, Updated: 2, Ready: 3, Available: 3, Old: 1, Message: "Partitioned rollout complete: 2 new pods have been updated", Done: true},
+		},
+		"rolling": {
+			status: appsv1.StatefulSetStatus{ObservedGeneration: 1, ReadyReplicas: 3, CurrentReplicas: 1, UpdatedReplicas: 2, CurrentRevision: "r1", UpdateRevision: "r2"},
+			e:      RolloutStatus{Desired: 3, Updated: 2, Ready: 3, Available: 3, Old: 1, Message: "Waiting for rolling update to complete: 2 pods at revision r2..."},
+		},
+		"done": {
+			status: appsv1.StatefulSetStatus{ObservedGeneration: 1, ReadyReplicas: 3, CurrentReplicas: 3, UpdatedReplicas: 3, CurrentRevision: "r2", UpdateRevision: "r2"},
+			e:      RolloutStatus{Desired: 3, Updated: 3, Ready: 3, Available: 3, Message: "Successfully rolled out", Done: true},
+		},
+	}
+
+	for k, u := range uu {
+		t.Run(k, func(t *testing.T) {
+			sts := appsv1.StatefulSet{ObjectMeta: objMeta("sts1", "sts1"), Status: u.status}
+			sts.Generation = 1
+			sts.Spec.Replicas, sts.Spec.UpdateStrategy = int32Ptr(3), u.strategy
+			if sts.Spec.UpdateStrategy.Type == "" {
+				sts.Spec.UpdateStrategy.Type = appsv1.RollingUpdateStatefulSetStrategyType
+			}
+			assert.Equal(t, u.e, StatefulSetRolloutStatus(&sts))
+		})
+	}
+}
+
+func TestDaemonSetRolloutStatus(t *testing.T) {
+	uu := map[string]struct {
+		strategy   appsv1.DaemonSetUpdateStrategyType
+		generation int64
+		status     appsv1.DaemonSetStatus
+		e          RolloutStatus
+	}{
+		"onDelete": {
+			strategy: appsv1.OnDeleteDaemonSetStrategyType,
+			e:        RolloutStatus{Message: "Rollout status is only available for RollingUpdate strategy", Done: true},
+		},
+		"observing": {
+			generation: 2,
+			status:     appsv1.DaemonSetStatus{ObservedGeneration: 1, DesiredNumberScheduled: 3},
+			e:          RolloutStatus{Desired: 3, Message: "Waiting for daemon set spec update to be observed..."},
+		},
+		"updating": {
+			status: appsv1.DaemonSetStatus{DesiredNumberScheduled: 3, CurrentNumberScheduled: 3, UpdatedNumberScheduled: 1, NumberReady: 3, NumberAvailable: 3},
+			e:      RolloutStatus{Desired: 3, Updated: 1, Ready: 3, Available: 3, Old: 2, Message: "Waiting for rollout to finish: 1 out of 3 new pods have been updated..."},
+		},
+		"available": {
+			status: appsv1.DaemonSetStatus{DesiredNumberScheduled: 3, CurrentNumberScheduled: 3, UpdatedNumberScheduled: 3, NumberReady: 3, NumberAvailable: 2},
+			e:      RolloutStatus{Desired: 3, Updated: 3, Ready: 3, Available: 2, Message: "Waiting for rollout to finish: 2 of 3 updated pods are available..."},
+		},
+		"done": {
+			status: appsv1.DaemonSetStatus{DesiredNumberScheduled: 3, CurrentNumberScheduled: 3, UpdatedNumberScheduled: 3, NumberReady: 3, NumberAvailable: 3},
+			e:      RolloutStatus{Desired: 3, Updated: 3, Ready: 3, Available: 3, Message: "Successfully rolled out", Done: true},
+		},
+	}
+
+	for k, u := range uu {
+		t.Run(k, func(t *testing.T) {
+			ds := appsv1.DaemonSet{ObjectMeta: objMeta("ds1", "ds1"), Status: u.status}
+			ds.Generation = u.generation
+			ds.Spec.UpdateStrategy.Type = u.strategy
+			if u.strategy == "" {
+				ds.Spec.UpdateStrategy.Type = appsv1.RollingUpdateDaemonSetStrategyType
+			}
+			assert.Equal(t, u.e, DaemonSetRolloutStatus(&ds))
+		})
+	}
+}
+
+// Helpers...
+
+func partitioned(p int32) appsv1.StatefulSetUpdateStrategy {
+	return appsv1.StatefulSetUpdateStrategy{
+		Type:          appsv1.RollingUpdateStatefulSetStrategyType,
+		RollingUpdate: &appsv1.RollingUpdateStatefulSetStrategy{Partition: int32Ptr(p)},
+	}
+}
+
+func revisionRS(n, rev, cause, img string) appsv1.ReplicaSet {
+	rs := appsv1.ReplicaSet{ObjectMeta: ownedMeta(n, n, "Deployment", "dp1")}
+	rs.Annotations = map[string]string{revisionAnnotation: rev}
+	if cause != "" {
+		rs.Annotations[ChangeCauseAnnotation] = cause
+	}
+	rs.Spec.Template.Labels = map[string]string{"app": "fred", podTemplateHashKey: "abc"}
+	rs.Spec.Template.Spec.Containers = []v1.Container{{Name: "c1", Image: img}}
+
+	return rs
+}
@@ -0,0 +1,165 @@
+package k8s
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// Maximum number of resources searched concurrently.
+const searchWorkers = 10
+
+// Annotations that are too noisy to be searched.
+var searchSkipAnnotations = map[string]bool{
+	"kubectl.kubernetes.io/last-applied-configuration": true,
+}
+
+type (
+	// SearchTarget represents a resource kind to be searched.
+	SearchTarget struct {
+		GVR        string
+		Kind       string
+		Namespaced bool
+	}
+
+	// SearchHit represents a resource matching a search term.
+	SearchHit struct {
+		GVR       string
+		Kind      string
+		Namespace string
+		Name      string
+		Match     string
+	}
+)
+
+// Search lists all given targets and returns the resources whose name, labels or
+// annotations contain the given term.
+func Search(c Connection, targets []SearchTarget, ns, term string) ([]SearchHit, []error) {
+	var (
+		hits []SearchHit
+		errs []error
+		mx   sync.Mutex
+		wg   sync.WaitGroup
+		sem  = make(chan struct{}, searchWorkers)
+	)
+
+	for _, t := range targets {
+		wg.Add(1)
+		go func(t SearchTarget) {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			hh, err := searchTarget(c, t, ns, term)
+			mx.Lock()
+			defer mx.Unlock()
+			if err != nil {
+				errs = append(errs, fmt.Errorf("%s: %v", t.GVR, err))
+				return
+			}
+			hits = append(hits, hh...)
+		}(t)
+	}
+	wg.Wait()
+	SortSearchHits(hits)
+
+	return hits, errs
+}
+
+func searchTarget(c Connection, t SearchTarget, ns, term string) ([]SearchHit, error) {
+	var (
+		res  = c.DynDialOrDie().Resource(GVR(t.GVR).AsGVR())
+		opts = metav1.ListOptions{}
+		l    *unstructured.UnstructuredList
+		err  error
+	)
+	if t.Namespaced && ns != "" {
+		l, err = res.Namespace(ns).List(opts)
+	} else {
+		l, err = res.List(opts)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return searchItems(t, l.Items, term), nil
+}
+
+func searchItems(t SearchTarget, items []unstructured.Unstructured, term string) []SearchHit {
+	var hh []SearchHit
+	for i := range items {
+		m, ok := MatchMeta(&items[i], term)
+		if !ok {
+			continue
+		}
+		hh = append(hh, SearchHit{
+			GVR:       t.GVR,
+			Kind:      t.Kind,
+			Namespace: items[i].GetNamespace(),
+			Name:      items[i].GetName(),
+			Match:     m,
+		})
+	}
+
+	return hh
+}
+
+// MatchMeta checks if a resource name, labels or annotations contain the given
+// term. It returns a description of the first match.
+func MatchMeta(o metav1.Object, term string) (string, bool) {
+	term = strings.ToLower(term)
+	if term == "" {
+		return "", false
+	}
+	contains := func(s string) bool {
+		return strings.Contains(strings.ToLower(s), term)
+	}
+
+	if contains(o.GetName()) {
+		return "name", true
+	}
+	for _, k := range sortedKeys(o.GetLabels()) {
+		v := o.GetLabels()[k]
+		if contains(k) || contains(v) {
+			return "label " + k + "=" + v, true
+		}
+	}
+	for _, k := range sortedKeys(o.GetAnnotations()) {
+		if searchSkipAnnotations[k] {
+			continue
+		}
+		v := o.GetAnnotations()[k]
+		if contains(k) || contains(v) {
+			return "annotation " + k + "=" + v, true
+		}
+	}
+
+	return "", false
+}
+
+// SortSearchHits orders search hits by kind, namespace and name.
+func SortSearchHits(hh []SearchHit) {
+	sort.Slice(hh, func(i, j int) bool {
+		if hh[i].Kind != hh[j].Kind {
+			return hh[i].Kind < hh[j].Kind
+		}
+		if hh[i].Namespace != hh[j].Namespace {
+			return hh[i].Namespace < hh[j].Namespace
+		}
+		return hh[i].Name < hh[j].Name
+	})
+}
+
+func sortedKeys(m map[string]string) []string {
+	kk := make([]string, 0, len(m))
+	for k := range m {
+		kk = append(kk, k)
+	}
+	sort.Strings(kk)
+
+	return kk
+}
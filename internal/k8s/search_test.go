@@ -0,0 +1,94 @@
+package k8s
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestMatchMeta(t *testing.T) {
+	uu := map[string]struct {
+		m     metav1.ObjectMeta
+		term  string
+		match string
+		e     bool
+	}{
+		"name": {
+			m:     metav1.ObjectMeta{Name: "checkout-svc"},
+			term:  "Checkout",
+			match: "name",
+			e:     true,
+		},
+		"labelValue": {
+			m:     metav1.ObjectMeta{Name: "fred", Labels: map[string]string{"app": "checkout", "tier": "web"}},
+			term:  "checkout",
+			match: "label app=checkout",
+			e:     true,
+		},
+		"labelKey": {
+			m:     metav1.ObjectMeta{Name: "fred", Labels: map[string]string{"checkout/team": "blee"}},
+			term:  "checkout",
+			match: "label checkout/team=blee",
+			e:     true,
+		},
+		"annotation": {
+			m:     metav1.ObjectMeta{Name: "fred", Annotations: map[string]string{"owner": "checkout-team"}},
+			term:  "checkout",
+			match: "annotation owner=checkout-team",
+			e:     true,
+		},
+		"lastApplied": {
+			m: metav1.ObjectMeta{
+				Name:        "fred",
+				Annotations: map[string]string{"kubectl.kubernetes.io/last-applied-configuration": `{"name":"checkout"}`},
+			},
+			term: "checkout",
+		},
+		"noMatch": {
+			m:    metav1.ObjectMeta{Name: "fred", Labels: map[string]string{"app": "blee"}},
+			term: "checkout",
+		},
+		"blank": {
+			m: metav1.ObjectMeta{Name: "fred"},
+		},
+	}
+
+	for k, u := range uu {
+		t.Run(k, func(t *testing.T) {
+			m, ok := MatchMeta(&u.m, u.term)
+			assert.Equal(t, u.e, ok)
+			assert.Equal(t, u.match, m)
+		})
+	}
+}
+
+func TestSearchItems(t *testing.T) {
+	items := []unstructured.Unstructured{
+		searchItem("ns2", "checkout", nil),
+		searchItem("ns1", "fred", map[string]string{"app": "checkout"}),
+		searchItem("ns1", "blee", nil),
+		searchItem("ns1", "checkout-db", nil),
+	}
+	tg := SearchTarget{GVR: "v1/services", Kind: "Service", Namespaced: true}
+
+	hh := searchItems(tg, items, "checkout")
+	SortSearchHits(hh)
+	assert.Equal(t, []SearchHit{
+		{GVR: "v1/services", Kind: "Service", Namespace: "ns1", Name: "checkout-db", Match: "name"},
+		{GVR: "v1/services", Kind: "Service", Namespace: "ns1", Name: "fred", Match: "label app=checkout"},
+		{GVR: "v1/services", Kind: "Service", Namespace: "ns2", Name: "checkout", Match: "name"},
+	}, hh)
+}
+
+// Helpers...
+
+func searchItem(ns, n string, ll map[string]string) unstructured.Unstructured {
+	var o unstructured.Unstructured
+	o.SetNamespace(ns)
+	o.SetName(n)
+	o.SetLabels(ll)
+
+	return o
+}
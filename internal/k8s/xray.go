@@ -0,0 +1,617 @@
+package k8s
+
+import (
+	"fmt"
+	"sort"
+
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	batchv1beta1 "k8s.io/api/batch/v1beta1"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/metadata"
+)
+
+const (
+	// XRayOK indicates a healthy resource.
+	XRayOK XRayStatus = iota
+	// XRayPending indicates a resource that is not ready yet.
+	XRayPending
+	// XRayToast indicates an unhealthy resource.
+	XRayToast
+	// XRayUnknown indicates a resource which status could not be determined.
+	XRayUnknown
+)
+
+// Resource kinds tracked in an ownership tree.
+const (
+	XRayNamespace      = "Namespace"
+	XRayDeployment     = "Deployment"
+	XRayReplicaSet     = "ReplicaSet"
+	XRayStatefulSet    = "StatefulSet"
+	XRayDaemonSet      = "DaemonSet"
+	XRayCronJob        = "CronJob"
+	XRayJob            = "Job"
+	XRayPod            = "Pod"
+	XRayContainer      = "Container"
+	XRayConfigMap      = "ConfigMap"
+	XRaySecret         = "Secret"
+	XRayPVC            = "PersistentVolumeClaim"
+	XRayServiceAccount = "ServiceAccount"
+)
+
+type (
+	// XRayStatus represents a resource health.
+	XRayStatus int
+
+	// XRayNode represents a resource in an ownership tree.
+	XRayNode struct {
+		Kind      string
+		Namespace string
+		Name      string
+		Status    XRayStatus
+		Info      string
+		Children  []*XRayNode
+	}
+
+	// PodRef represents a resource referenced by a pod spec.
+	PodRef struct {
+		Kind string
+		Name string
+	}
+
+	// XRaySource tracks the resources used to build ownership trees.
+	XRaySource struct {
+		Deployments     []appsv1.Deployment
+		ReplicaSets     []appsv1.ReplicaSet
+		StatefulSets    []appsv1.StatefulSet
+		DaemonSets      []appsv1.DaemonSet
+		CronJobs        []batchv1beta1.CronJob
+		Jobs            []batchv1.Job
+		Pods            []v1.Pod
+		ConfigMaps      []metav1.ObjectMeta
+		Secrets         []metav1.ObjectMeta
+		PVCs            []v1.PersistentVolumeClaim
+		ServiceAccounts []v1.ServiceAccount
+		// Unlisted tracks the reference kinds that could not be fetched.
+		Unlisted map[string]bool
+	}
+)
+
+// Path returns the node fully qualified name.
+func (n *XRayNode) Path() string {
+	if n.Namespace == "" {
+		return n.Name
+	}
+
+	return n.Namespace + "/" + n.Name
+}
+
+// Find returns the first node matching the given kind and path.
+func (n *XRayNode) Find(kind, path string) (*XRayNode, bool) {
+	if n.Kind == kind && n.Path() == path {
+		return n, true
+	}
+	for _, c := range n.Children {
+		if f, ok := c.Find(kind, path); ok {
+			return f, true
+		}
+	}
+
+	return nil, false
+}
+
+// Rollup returns the worst status across the node and its descendants.
+// Unknown statuses are ignored.
+func (n *XRayNode) Rollup() XRayStatus {
+	s := n.Status
+	for _, c := range n.Children {
+		if cs := c.Rollup(); cs != XRayUnknown && (s == XRayUnknown || cs > s) {
+			s = cs
+		}
+	}
+
+	return s
+}
+
+// PodRefs returns the service account, configmaps, secrets and pvcs a pod
+// refers to, in spec order and without duplicates.
+func PodRefs(po v1.Pod) []PodRef {
+	var (
+		rr   []PodRef
+		seen = make(map[PodRef]bool)
+	)
+	ref := func(kind, name string) {
+		r := PodRef{Kind: kind, Name: name}
+		if name == "" || seen[r] {
+			return
+		}
+		seen[r] = true
+		rr = append(rr, r)
+	}
+
+	sa := po.Spec.ServiceAccountName
+	if sa == "" {
+		sa = "default"
+	}
+	ref(XRayServiceAccount, sa)
+	for _, s := range po.Spec.ImagePullSecrets {
+		ref(XRaySecret, s.Name)
+	}
+	for _, vol := range po.Spec.Volumes {
+		switch {
+		case vol.ConfigMap != nil:
+			ref(XRayConfigMap, vol.ConfigMap.Name)
+		case vol.Secret != nil:
+			ref(XRaySecret, vol.Secret.SecretName)
+		case vol.PersistentVolumeClaim != nil:
+			ref(XRayPVC, vol.PersistentVolumeClaim.ClaimName)
+		}
+	}
+	for _, co := range podContainers(po) {
+		for _, e := range co.EnvFrom {
+			if e.ConfigMapRef != nil {
+				ref(XRayConfigMap, e.ConfigMapRef.Name)
+			}
+			if e.SecretRef != nil {
+				ref(XRaySecret, e.SecretRef.Name)
+			}
+		}
+		for _, e := range co.Env {
+			if e.ValueFrom == nil {
+				continue
+			}
+			if e.ValueFrom.ConfigMapKeyRef != nil {
+				ref(XRayConfigMap, e.ValueFrom.ConfigMapKeyRef.Name)
+			}
+			if e.ValueFrom.SecretKeyRef != nil {
+				ref(XRaySecret, e.ValueFrom.SecretKeyRef.Name)
+			}
+		}
+	}
+
+	return rr
+}
+
+// FetchXRay lists the resources in a namespace needed to build ownership trees
+// for the given pods. Pods are handed in as they are already cached by the informer.
+func FetchXRay(c Connection, ns string, pods []v1.Pod) (*XRaySource, error) {
+	var (
+		src  = XRaySource{Pods: pods}
+		opts metav1.ListOptions
+	)
+	dial := c.DialOrDie()
+
+	dps, err := dial.AppsV1().Deployments(ns).List(opts)
+	if err != nil {
+		return nil, err
+	}
+	src.Deployments = dps.Items
+	rss, err := dial.AppsV1().ReplicaSets(ns).List(opts)
+	if err != nil {
+		return nil, err
+	}
+	src.ReplicaSets = rss.Items
+	sts, err := dial.AppsV1().StatefulSets(ns).List(opts)
+	if err != nil {
+		return nil, err
+	}
+	src.StatefulSets = sts.Items
+	dss, err := dial.AppsV1().DaemonSets(ns).List(opts)
+	if err != nil {
+		return nil, err
+	}
+	src.DaemonSets = dss.Items
+	jobs, err := dial.BatchV1().Jobs(ns).List(opts)
+	if err != nil {
+		return nil, err
+	}
+	src.Jobs = jobs.Items
+
+	// Cronjobs and references are nice to have. Skip them if unavailable.
+	src.Unlisted = make(map[string]bool)
+	if cjs, err := dial.BatchV1beta1().CronJobs(ns).List(opts); err == nil {
+		src.CronJobs = cjs.Items
+	}
+	if src.ConfigMaps, err = fetchConfigMaps(c, ns); err != nil {
+		src.Unlisted[XRayConfigMap] = true
+	}
+	if src.Secrets, err = fetchSecrets(dial, src.Pods); err != nil {
+		src.Unlisted[XRaySecret] = true
+	}
+	if pvcs, err := dial.CoreV1().PersistentVolumeClaims(ns).List(opts); err == nil {
+		src.PVCs = pvcs.Items
+	} else {
+		src.Unlisted[XRayPVC] = true
+	}
+	if sas, err := dial.CoreV1().ServiceAccounts(ns).List(opts); err == nil {
+		src.ServiceAccounts = sas.Items
+	} else {
+		src.Unlisted[XRayServiceAccount] = true
+	}
+
+	return &src, nil
+}
+
+// FetchConfigMaps lists the configmaps metadata only so their payloads are not listed.
+func fetchConfigMaps(c Connection, ns string) ([]metav1.ObjectMeta, error) {
+	dial, err := metadata.NewForConfig(c.RestConfigOrDie())
+	if err != nil {
+		return nil, err
+	}
+	cms, err := dial.Resource(v1.SchemeGroupVersion.WithResource("configmaps")).Namespace(ns).List(metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	mm := make([]metav1.ObjectMeta, 0, len(cms.Items))
+	for _, o := range cms.Items {
+		mm = append(mm, o.ObjectMeta)
+	}
+
+	return mm, nil
+}
+
+// FetchSecrets looks up the secrets referenced by the given pods by name so
+// their payloads are not listed. Only the secrets metadata is retained.
+func fetchSecrets(dial kubernetes.Interface, pods []v1.Pod) ([]metav1.ObjectMeta, error) {
+	var (
+		mm   []metav1.ObjectMeta
+		seen = make(map[string]bool)
+		lerr error
+	)
+	for _, po := range pods {
+		for _, r := range PodRefs(po) {
+			k := refKey(r.Kind, po.Namespace, r.Name)
+			if r.Kind != XRaySecret || seen[k] {
+				continue
+			}
+			seen[k] = true
+			sec, err := dial.CoreV1().Secrets(po.Namespace).Get(r.Name, metav1.GetOptions{})
+			switch {
+			case err == nil:
+				mm = append(mm, sec.ObjectMeta)
+			case !errors.IsNotFound(err):
+				lerr = err
+			}
+		}
+	}
+
+	return mm, lerr
+}
+
+// BuildXRay assembles ownership trees from the given resources, one per
+// namespace. Resources without a controller are rooted at their namespace.
+func BuildXRay(src *XRaySource) []*XRayNode {
+	b := xrayBuilder{
+		src:      src,
+		children: make(map[types.UID][]*XRayNode),
+		refs:     make(map[string]bool),
+		owners:   make(map[types.UID]bool),
+	}
+	b.indexRefs()
+
+	var roots []*XRayNode
+	add := func(meta metav1.ObjectMeta, n *XRayNode) {
+		if ref := metav1.GetControllerOf(&meta); ref != nil && b.owners[ref.UID] {
+			b.children[ref.UID] = append(b.children[ref.UID], n)
+			return
+		}
+		roots = append(roots, n)
+	}
+	uids := make(map[*XRayNode]types.UID)
+	for _, o := range src.Deployments {
+		n := deploymentNode(o)
+		uids[n] = o.UID
+		add(o.ObjectMeta, n)
+	}
+	for _, o := range src.ReplicaSets {
+		if o.Status.Replicas == 0 && o.Spec.Replicas != nil && *o.Spec.Replicas == 0 {
+			continue
+		}
+		n := replicaSetNode(o)
+		uids[n] = o.UID
+		add(o.ObjectMeta, n)
+	}
+	for _, o := range src.StatefulSets {
+		n := statefulSetNode(o)
+		uids[n] = o.UID
+		add(o.ObjectMeta, n)
+	}
+	for _, o := range src.DaemonSets {
+		n := daemonSetNode(o)
+		uids[n] = o.UID
+		add(o.ObjectMeta, n)
+	}
+	for _, o := range src.CronJobs {
+		n := cronJobNode(o)
+		uids[n] = o.UID
+		add(o.ObjectMeta, n)
+	}
+	for _, o := range src.Jobs {
+		n := jobNode(o)
+		uids[n] = o.UID
+		add(o.ObjectMeta, n)
+	}
+	for _, o := range src.Pods {
+		add(o.ObjectMeta, b.podNode(o))
+	}
+	for n, uid := range uids {
+		n.Children = b.children[uid]
+		sortNodes(n.Children)
+	}
+
+	nss := make(map[string]*XRayNode)
+	for _, r := range roots {
+		ns, ok := nss[r.Namespace]
+		if !ok {
+			ns = &XRayNode{Kind: XRayNamespace, Name: r.Namespace}
+			nss[r.Namespace] = ns
+		}
+		ns.Children = append(ns.Children, r)
+	}
+	res := make([]*XRayNode, 0, len(nss))
+	for _, ns := range nss {
+		sortNodes(ns.Children)
+		res = append(res, ns)
+	}
+	sortNodes(res)
+
+	return res
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+type xrayBuilder struct {
+	src      *XRaySource
+	children map[types.UID][]*XRayNode
+	refs     map[string]bool
+	owners   map[types.UID]bool
+}
+
+// IndexRefs indexes the pods references and the controllers uids.
+func (b *xrayBuilder) indexRefs() {
+	for _, o := range b.src.ConfigMaps {
+		b.refs[refKey(XRayConfigMap, o.Namespace, o.Name)] = true
+	}
+	for _, o := range b.src.Secrets {
+		b.refs[refKey(XRaySecret, o.Namespace, o.Name)] = true
+	}
+	for _, o := range b.src.ServiceAccounts {
+		b.refs[refKey(XRayServiceAccount, o.Namespace, o.Name)] = true
+	}
+	for _, o := range b.src.PVCs {
+		b.refs[refKey(XRayPVC, o.Namespace, o.Name)] = o.Status.Phase == v1.ClaimBound
+	}
+
+	for _, o := range b.src.Deployments {
+		b.owners[o.UID] = true
+	}
+	for _, o := range b.src.ReplicaSets {
+		b.owners[o.UID] = true
+	}
+	for _, o := range b.src.StatefulSets {
+		b.owners[o.UID] = true
+	}
+	for _, o := range b.src.DaemonSets {
+		b.owners[o.UID] = true
+	}
+	for _, o := range b.src.CronJobs {
+		b.owners[o.UID] = true
+	}
+	for _, o := range b.src.Jobs {
+		b.owners[o.UID] = true
+	}
+}
+
+func (b *xrayBuilder) podNode(po v1.Pod) *XRayNode {
+	n := XRayNode{Kind: XRayPod, Namespace: po.Namespace, Name: po.Name, Status: podStatus(po), Info: podInfo(po)}
+
+	statuses := make(map[string]v1.ContainerStatus, len(po.Status.ContainerStatuses))
+	for _, s := range po.Status.InitContainerStatuses {
+		statuses[s.Name] = s
+	}
+	for _, s := range po.Status.ContainerStatuses {
+		statuses[s.Name] = s
+	}
+	for _, co := range podContainers(po) {
+		n.Children = append(n.Children, containerNode(po, co, statuses[co.Name]))
+	}
+
+	for _, r := range PodRefs(po) {
+		k := refKey(r.Kind, po.Namespace, r.Name)
+		s, info := XRayOK, ""
+		if bound, ok := b.refs[k]; !ok && b.src.Unlisted[r.Kind] {
+			s, info = XRayUnknown, "unknown"
+		} else if !ok {
+			s, info = XRayToast, "missing"
+		} else if !bound {
+			s, info = XRayPending, "not bound"
+		}
+		n.Children = append(n.Children, &XRayNode{Kind: r.Kind, Namespace: po.Namespace, Name: r.Name, Status: s, Info: info})
+	}
+
+	return &n
+}
+
+func deploymentNode(o appsv1.Deployment) *XRayNode {
+	desired := replicas(o.Spec.Replicas)
+	return &XRayNode{
+		Kind:      XRayDeployment,
+		Namespace: o.Namespace,
+		Name:      o.Name,
+		Status:    readyStatus(o.Status.ReadyReplicas, desired),
+		Info:      fmt.Sprintf("%d/%d ready", o.Status.ReadyReplicas, desired),
+	}
+}
+
+func replicaSetNode(o appsv1.ReplicaSet) *XRayNode {
+	desired := replicas(o.Spec.Replicas)
+	return &XRayNode{
+		Kind:      XRayReplicaSet,
+		Namespace: o.Namespace,
+		Name:      o.Name,
+		Status:    readyStatus(o.Status.ReadyReplicas, desired),
+		Info:      fmt.Sprintf("%d/%d ready", o.Status.ReadyReplicas, desired),
+	}
+}
+
+func statefulSetNode(o appsv1.StatefulSet) *XRayNode {
+	desired := replicas(o.Spec.Replicas)
+	return &XRayNode{
+		Kind:      XRayStatefulSet,
+		Namespace: o.Namespace,
+		Name:      o.Name,
+		Status:    readyStatus(o.Status.ReadyReplicas, desired),
+		Info:      fmt.Sprintf("%d/%d ready", o.Status.ReadyReplicas, desired),
+	}
+}
+
+func daemonSetNode(o appsv1.DaemonSet) *XRayNode {
+	return &XRayNode{
+		Kind:      XRayDaemonSet,
+		Namespace: o.Namespace,
+		Name:      o.Name,
+		Status:    readyStatus(o.Status.NumberReady, o.Status.DesiredNumberScheduled),
+		Info:      fmt.Sprintf("%d/%d ready", o.Status.NumberReady, o.Status.DesiredNumberScheduled),
+	}
+}
+
+func cronJobNode(o batchv1beta1.CronJob) *XRayNode {
+	n := XRayNode{Kind: XRayCronJob, Namespace: o.Namespace, Name: o.Name, Info: o.Spec.Schedule}
+	if o.Spec.Suspend != nil && *o.Spec.Suspend {
+		n.Status, n.Info = XRayPending, "suspended"
+	}
+
+	return &n
+}
+
+func jobNode(o batchv1.Job) *XRayNode {
+	n := XRayNode{
+		Kind:      XRayJob,
+		Namespace: o.Namespace,
+		Name:      o.Name,
+		Info:      fmt.Sprintf("%d succeeded, %d failed", o.Status.Succeeded, o.Status.Failed),
+	}
+	switch {
+	case o.Status.Failed > 0:
+		n.Status = XRayToast
+	case o.Status.Active > 0:
+		n.Status = XRayPending
+	}
+
+	return &n
+}
+
+func containerNode(po v1.Pod, co v1.Container, s v1.ContainerStatus) *XRayNode {
+	n := XRayNode{Kind: XRayContainer, Namespace: po.Namespace, Name: co.Name, Info: co.Image}
+	switch {
+	case s.State.Waiting != nil:
+		n.Status, n.Info = XRayToast, s.State.Waiting.Reason
+		if s.State.Waiting.Reason == "ContainerCreating" || s.State.Waiting.Reason == "PodInitializing" {
+			n.Status = XRayPending
+		}
+	case s.State.Terminated != nil:
+		n.Info = s.State.Terminated.Reason
+		if s.State.Terminated.ExitCode != 0 {
+			n.Status = XRayToast
+		}
+	case s.State.Running != nil && !s.Ready:
+		n.Status, n.Info = XRayPending, "not ready"
+	case s.State.Running == nil:
+		n.Status = XRayPending
+	}
+	if s.RestartCount > 0 {
+		n.Info += fmt.Sprintf(" (%d restarts)", s.RestartCount)
+	}
+
+	return &n
+}
+
+func podStatus(po v1.Pod) XRayStatus {
+	switch po.Status.Phase {
+	case v1.PodSucceeded:
+		return XRayOK
+	case v1.PodFailed, v1.PodUnknown:
+		return XRayToast
+	case v1.PodPending:
+		return XRayPending
+	}
+	if po.DeletionTimestamp != nil {
+		return XRayPending
+	}
+	for _, c := range po.Status.Conditions {
+		if c.Type == v1.PodReady && c.Status != v1.ConditionTrue {
+			return XRayToast
+		}
+	}
+
+	return XRayOK
+}
+
+func podInfo(po v1.Pod) string {
+	if po.Status.Reason != "" {
+		return po.Status.Reason
+	}
+
+	return string(po.Status.Phase)
+}
+
+func readyStatus(ready, desired int32) XRayStatus {
+	switch {
+	case ready >= desired:
+		return XRayOK
+	case ready == 0:
+		return XRayToast
+	default:
+		return XRayPending
+	}
+}
+
+func replicas(r *int32) int32 {
+	if r == nil {
+		return 1
+	}
+
+	return *r
+}
+
+// PodContainers returns a pod init containers followed by its containers.
+func podContainers(po v1.Pod) []v1.Container {
+	cc := make([]v1.Container, 0, len(po.Spec.InitContainers)+len(po.Spec.Containers))
+	cc = append(cc, po.Spec.InitContainers...)
+
+	return append(cc, po.Spec.Containers...)
+}
+
+func refKey(kind, ns, n string) string {
+	return kind + ":" + ns + "/" + n
+}
+
+// SortNodes orders workloads before pods and references, then by name.
+func sortNodes(nn []*XRayNode) {
+	rank := map[string]int{
+		XRayDeployment: 0, XRayStatefulSet: 0, XRayDaemonSet: 0, XRayCronJob: 0,
+		XRayReplicaSet: 1, XRayJob: 1,
+		XRayPod:       2,
+		XRayContainer: 3,
+	}
+	order := func(k string) int {
+		if r, ok := rank[k]; ok {
+			return r
+		}
+		return 4
+	}
+	sort.SliceStable(nn, func(i, j int) bool {
+		if oi, oj := order(nn[i].Kind), order(nn[j].Kind); oi != oj {
+			return oi < oj
+		}
+		if nn[i].Kind != nn[j].Kind {
+			return nn[i].Kind < nn[j].Kind
+		}
+		return nn[i].Name < nn[j].Name
+	})
+}
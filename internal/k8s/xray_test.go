@@ -0,0 +1,309 @@
+package k8s
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func TestBuildXRay(t *testing.T) {
+	src := XRaySource{
+		Deployments: []appsv1.Deployment{
+			{
+				ObjectMeta: objMeta("dp1", "dp1"),
+				Spec:       appsv1.DeploymentSpec{Replicas: int32Ptr(2)},
+				Status:     appsv1.DeploymentStatus{ReadyReplicas: 1},
+			},
+		},
+		ReplicaSets: []appsv1.ReplicaSet{
+			{
+				ObjectMeta: ownedMeta("rs1", "rs1", "Deployment", "dp1"),
+				Spec:       appsv1.ReplicaSetSpec{Replicas: int32Ptr(2)},
+				Status:     appsv1.ReplicaSetStatus{Replicas: 2, ReadyReplicas: 1},
+			},
+			{
+				ObjectMeta: ownedMeta("rs0", "rs0", "Deployment", "dp1"),
+				Spec:       appsv1.ReplicaSetSpec{Replicas: int32Ptr(0)},
+			},
+		},
+		Pods: []v1.Pod{
+			runningPod(ownedMeta("p1", "p1", "ReplicaSet", "rs1"), true),
+			runningPod(ownedMeta("p2", "p2", "ReplicaSet", "rs1"), false),
+			runningPod(objMeta("p3", "p3"), true),
+		},
+		ConfigMaps: []metav1.ObjectMeta{objMeta("cm1", "cm1")},
+		ServiceAccounts: []v1.ServiceAccount{
+			{ObjectMeta: objMeta("default", "sa1")},
+		},
+	}
+
+	roots := BuildXRay(&src)
+	assert.Equal(t, 1, len(roots))
+	ns := roots[0]
+	assert.Equal(t, XRayNamespace, ns.Kind)
+	assert.Equal(t, "default", ns.Name)
+	assert.Equal(t, 2, len(ns.Children))
+
+	dp := ns.Children[0]
+	assert.Equal(t, XRayDeployment, dp.Kind)
+	assert.Equal(t, XRayPending, dp.Status)
+	assert.Equal(t, "1/2 ready", dp.Info)
+	assert.Equal(t, 1, len(dp.Children))
+	rs := dp.Children[0]
+	assert.Equal(t, "rs1", rs.Name)
+	assert.Equal(t, 2, len(rs.Children))
+	assert.Equal(t, XRayToast, dp.Rollup())
+
+	p2, ok := ns.Find(XRayPod, "default/p2")
+	assert.True(t, ok)
+	assert.Equal(t, XRayToast, p2.Status)
+	kinds := make(map[string]XRayStatus)
+	for _, c := range p2.Children {
+		kinds[c.Kind+"/"+c.Name] = c.Status
+	}
+	assert.Equal(t, map[string]XRayStatus{
+		"Container/c1":             XRayPending,
+		"ServiceAccount/default":   XRayOK,
+		"ConfigMap/cm1":            XRayOK,
+		"Secret/s1":                XRayToast,
+		"PersistentVolumeClaim/v1": XRayToast,
+	}, kinds)
+
+	p3 := ns.Children[1]
+	assert.Equal(t, "p3", p3.Name)
+	assert.Equal(t, XRayOK, p3.Status)
+}
+
+func TestBuildXRayUnlisted(t *testing.T) {
+	src := XRaySource{
+		Deployments: []appsv1.Deployment{
+			{
+				ObjectMeta: objMeta("dp1", "dp1"),
+				Spec:       appsv1.DeploymentSpec{Replicas: int32Ptr(1)},
+				Status:     appsv1.DeploymentStatus{ReadyReplicas: 1},
+			},
+		},
+		Pods:            []v1.Pod{withRefs(runningPod(ownedMeta("p1", "p1", "Deployment", "dp1"), true))},
+		ConfigMaps:      []metav1.ObjectMeta{objMeta("cm1", "cm1")},
+		Secrets:         []metav1.ObjectMeta{objMeta("s1", "s1")},
+		ServiceAccounts: []v1.ServiceAccount{{ObjectMeta: objMeta("default", "sa1")}},
+		Unlisted:        map[string]bool{XRaySecret: true, XRayPVC: true},
+	}
+
+	roots := BuildXRay(&src)
+	p1, ok := roots[0].Find(XRayPod, "default/p1")
+	assert.True(t, ok)
+	kinds := make(map[string]string)
+	for _, c := range p1.Children {
+		kinds[c.Kind+"/"+c.Name] = c.Info
+	}
+	assert.Equal(t, map[string]string{
+		"Container/c1":             "fred",
+		"ServiceAccount/default":   "",
+		"ConfigMap/cm1":            "",
+		"Secret/s1":                "",
+		"Secret/s2":                "unknown",
+		"PersistentVolumeClaim/v1": "unknown",
+	}, kinds)
+
+	dp := roots[0].Children[0]
+	assert.Equal(t, XRayDeployment, dp.Kind)
+	assert.Equal(t, XRayOK, dp.Rollup())
+}
+
+func TestXRayRollup(t *testing.T) {
+	uu := map[string]struct {
+		n XRayNode
+		e XRayStatus
+	}{
+		"ok": {
+			n: XRayNode{Children: []*XRayNode{{Status: XRayOK}}},
+			e: XRayOK,
+		},
+		"toast": {
+			n: XRayNode{Children: []*XRayNode{{Status: XRayPending}, {Children: []*XRayNode{{Status: XRayToast}}}}},
+			e: XRayToast,
+		},
+		"unknown": {
+			n: XRayNode{Status: XRayPending, Children: []*XRayNode{{Status: XRayUnknown}}},
+			e: XRayPending,
+		},
+		"unknownRoot": {
+			n: XRayNode{Status: XRayUnknown, Children: []*XRayNode{{Status: XRayOK}}},
+			e: XRayOK,
+		},
+	}
+
+	for k, u := range uu {
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, u.n.Rollup())
+		})
+	}
+}
+
+func TestXRayContainerNode(t *testing.T) {
+	uu := map[string]struct {
+		s    v1.ContainerStatus
+		e    XRayStatus
+		info string
+	}{
+		"running": {
+			s:    v1.ContainerStatus{Ready: true, State: v1.ContainerState{Running: &v1.ContainerStateRunning{}}},
+			e:    XRayOK,
+			info: "fred",
+		},
+		"notReady": {
+			s:    v1.ContainerStatus{State: v1.ContainerState{Running: &v1.ContainerStateRunning{}}},
+			e:    XRayPending,
+			info: "not ready",
+		},
+		"crashing": {
+			s: v1.ContainerStatus{
+				RestartCount: 3,
+				State:        v1.ContainerState{Waiting: &v1.ContainerStateWaiting{Reason: "CrashLoopBackOff"}},
+			},
+			e:    XRayToast,
+			info: "CrashLoopBackOff (3 restarts)",
+		},
+		"creating": {
+			s:    v1.ContainerStatus{State: v1.ContainerState{Waiting: &v1.ContainerStateWaiting{Reason: "ContainerCreating"}}},
+			e:    XRayPending,
+			info: "ContainerCreating",
+		},
+		"completed": {
+			s:    v1.ContainerStatus{State: v1.ContainerState{Terminated: &v1.ContainerStateTerminated{Reason: "Completed"}}},
+			e:    XRayOK,
+			info: "Completed",
+		},
+		"errored": {
+			s:    v1.ContainerStatus{State: v1.ContainerState{Terminated: &v1.ContainerStateTerminated{Reason: "Error", ExitCode: 1}}},
+			e:    XRayToast,
+			info: "Error",
+		},
+	}
+
+	for k, u := range uu {
+		t.Run(k, func(t *testing.T) {
+			n := containerNode(v1.Pod{}, v1.Container{Name: "c1", Image: "fred"}, u.s)
+			assert.Equal(t, u.e, n.Status)
+			assert.Equal(t, u.info, n.Info)
+		})
+	}
+}
+
+func TestXRayReadyStatus(t *testing.T) {
+	uu := map[string]struct {
+		ready, desired int32
+		e              XRayStatus
+	}{
+		"ready":   {2, 2, XRayOK},
+		"scaled0": {0, 0, XRayOK},
+		"partial": {1, 2, XRayPending},
+		"none":    {0, 2, XRayToast},
+	}
+
+	for k, u := range uu {
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, readyStatus(u.ready, u.desired))
+		})
+	}
+}
+
+// Helpers...
+
+func int32Ptr(i int32) *int32 {
+	return &i
+}
+
+func objMeta(n, uid string) metav1.ObjectMeta {
+	return metav1.ObjectMeta{Namespace: "default", Name: n, UID: types.UID(uid)}
+}
+
+func ownedMeta(n, uid, kind, owner string) metav1.ObjectMeta {
+	m := objMeta(n, uid)
+	ctrl := true
+	m.OwnerReferences = []metav1.OwnerReference{
+		{Kind: kind, Name: owner, UID: types.UID(owner), Controller: &ctrl},
+	}
+
+	return m
+}
+
+func withRefs(po v1.Pod) v1.Pod {
+	po.Spec.Volumes = []v1.Volume{
+		{Name: "cfg", VolumeSource: v1.VolumeSource{ConfigMap: &v1.ConfigMapVolumeSource{LocalObjectReference: v1.LocalObjectReference{Name: "cm1"}}}},
+		{Name: "data", VolumeSource: v1.VolumeSource{PersistentVolumeClaim: &v1.PersistentVolumeClaimVolumeSource{ClaimName: "v1"}}},
+		{Name: "s1", VolumeSource: v1.VolumeSource{Secret: &v1.SecretVolumeSource{SecretName: "s1"}}},
+		{Name: "s2", VolumeSource: v1.VolumeSource{Secret: &v1.SecretVolumeSource{SecretName: "s2"}}},
+	}
+
+	return po
+}
+
+func runningPod(m metav1.ObjectMeta, ready bool) v1.Pod {
+	cond := v1.ConditionTrue
+	if !ready {
+		cond = v1.ConditionFalse
+	}
+	po := v1.Pod{
+		ObjectMeta: m,
+		Spec: v1.PodSpec{
+			Containers: []v1.Container{{Name: "c1", Image: "fred"}},
+		},
+		Status: v1.PodStatus{
+			Phase:      v1.PodRunning,
+			Conditions: []v1.PodCondition{{Type: v1.PodReady, Status: cond}},
+			ContainerStatuses: []v1.ContainerStatus{
+				{Name: "c1", Ready: ready, State: v1.ContainerState{Running: &v1.ContainerStateRunning{}}},
+			},
+		},
+	}
+	if !ready {
+		po.Spec.Volumes = []v1.Volume{
+			{Name: "cfg", VolumeSource: v1.VolumeSource{ConfigMap: &v1.ConfigMapVolumeSource{LocalObjectReference: v1.LocalObjectReference{Name: "cm1"}}}},
+			{Name: "data", VolumeSource: v1.VolumeSource{PersistentVolumeClaim: &v1.PersistentVolumeClaimVolumeSource{ClaimName: "v1"}}},
+		}
+		po.Spec.Containers[0].EnvFrom = []v1.EnvFromSource{
+			{SecretRef: &v1.SecretEnvSource{LocalObjectReference: v1.LocalObjectReference{Name: "s1"}}},
+		}
+	}
+
+	return po
+}
+
+func TestPodRefs(t *testing.T) {
+	po := v1.Pod{
+		Spec: v1.PodSpec{
+			ServiceAccountName: "sa1",
+			ImagePullSecrets:   []v1.LocalObjectReference{{Name: "reg"}},
+			Volumes: []v1.Volume{
+				{Name: "cfg", VolumeSource: v1.VolumeSource{ConfigMap: &v1.ConfigMapVolumeSource{LocalObjectReference: v1.LocalObjectReference{Name: "cm1"}}}},
+				{Name: "data", VolumeSource: v1.VolumeSource{PersistentVolumeClaim: &v1.PersistentVolumeClaimVolumeSource{ClaimName: "pvc1"}}},
+			},
+			InitContainers: []v1.Container{
+				{Name: "i1", EnvFrom: []v1.EnvFromSource{{SecretRef: &v1.SecretEnvSource{LocalObjectReference: v1.LocalObjectReference{Name: "s1"}}}}},
+			},
+			Containers: []v1.Container{
+				{Name: "c1", Env: []v1.EnvVar{
+					{Name: "A", ValueFrom: &v1.EnvVarSource{ConfigMapKeyRef: &v1.ConfigMapKeySelector{LocalObjectReference: v1.LocalObjectReference{Name: "cm1"}}}},
+					{Name: "B", ValueFrom: &v1.EnvVarSource{SecretKeyRef: &v1.SecretKeySelector{LocalObjectReference: v1.LocalObjectReference{Name: "s2"}}}},
+					{Name: "C", Value: "blee"},
+				}},
+			},
+		},
+	}
+
+	assert.Equal(t, []PodRef{
+		{Kind: XRayServiceAccount, Name: "sa1"},
+		{Kind: XRaySecret, Name: "reg"},
+		{Kind: XRayConfigMap, Name: "cm1"},
+		{Kind: XRayPVC, Name: "pvc1"},
+		{Kind: XRaySecret, Name: "s1"},
+		{Kind: XRaySecret, Name: "s2"},
+	}, PodRefs(po))
+	assert.Equal(t, []PodRef{{Kind: XRayServiceAccount, Name: "default"}}, PodRefs(v1.Pod{}))
+}
@@ -2,12 +2,14 @@ package perf
 
 import (
 	"bytes"
+	"encoding/json"
 	"fmt"
-	"io"
 	"io/ioutil"
+	"math"
 	"net/http"
 	"os"
 	"path/filepath"
+	"sync"
 	"time"
 
 	"github.com/derailed/k9s/internal/config"
@@ -17,22 +19,88 @@ import (
 )
 
 const (
-	benchFmat = "%s_%s_%d.txt"
+	benchFmat = "%s_%s_%d"
 	k9sUA     = "k9s/0.0.7"
+	redacted  = "<redacted>"
+	// MaxRequests caps the number of requests issued by a timed run. Hey
+	// preallocates its result buffers up to this size.
+	maxRequests = 1000000
 )
 
 // K9sBenchDir directory to store K9s Benchmark files.
 var K9sBenchDir = filepath.Join(os.TempDir(), fmt.Sprintf("k9s-bench-%s", config.MustK9sUser()))
 
-// Benchmark puts a workload under load.
-type Benchmark struct {
-	canceled bool
-	config   config.BenchConfig
-	worker   *requester.Work
+type (
+	// Benchmark puts a workload under load.
+	Benchmark struct {
+		canceled bool
+		early    bool
+		config   config.BenchConfig
+		worker   workload
+		warmer   workload
+	}
+
+	// Workload generates load and reports on the outcome.
+	workload interface {
+		Run()
+		stop()
+		results(config.BenchConfig) (Report, []byte, error)
+	}
+
+	// Job tracks a hey workload.
+	job struct {
+		*requester.Work
+
+		once sync.Once
+		buff bytes.Buffer
+	}
+)
+
+func newJob(req *http.Request, body []byte, c, n int, qps float64, h2 bool) *job {
+	j := job{
+		Work: &requester.Work{
+			Request:     req,
+			RequestBody: body,
+			N:           n,
+			C:           c,
+			QPS:         qps,
+			H2:          h2,
+			Output:      jsonTmpl,
+		},
+	}
+	j.Writer = &j.buff
+	j.Init()
+
+	return &j
+}
+
+// Stop terminates the workload. Safe to call more than once.
+func (j *job) stop() {
+	if j == nil {
+		return
+	}
+	j.once.Do(j.Work.Stop)
+}
+
+func (j *job) results(cfg config.BenchConfig) (Report, []byte, error) {
+	res, err := readResults(&j.buff)
+	if err != nil {
+		return Report{}, nil, err
+	}
+	buff := new(bytes.Buffer)
+	err = writeText(buff, res)
+
+	return NewReport(cfg, res), buff.Bytes(), err
 }
 
 // NewBenchmark returns a new benchmark.
 func NewBenchmark(base string, cfg config.BenchConfig) (*Benchmark, error) {
+	if cfg.C <= 0 {
+		cfg.C = config.DefaultC
+	}
+	if cfg.N <= 0 {
+		cfg.N = config.DefaultN
+	}
 	b := Benchmark{config: cfg}
 	if err := b.init(base); err != nil {
 		return nil, err
@@ -41,6 +109,14 @@ func NewBenchmark(base string, cfg config.BenchConfig) (*Benchmark, error) {
 }
 
 func (b *Benchmark) init(base string) error {
+	qps, n := workerQPS(b.config.QPS, b.config.C), b.config.N
+	if b.config.Duration > 0 {
+		n = requestsFor(b.config.QPS, b.config.Duration, b.config.C)
+	}
+	if b.config.Scenario != nil {
+		return b.initScenario(base, n, qps)
+	}
+
 	req, err := http.NewRequest(b.config.HTTP.Method, base, nil)
 	if err != nil {
 		return err
@@ -63,18 +139,31 @@ func (b *Benchmark) init(base string) error {
 	}
 	req.Header.Set("User-Agent", ua)
 
-	b.worker = &requester.Work{
-		Request:     req,
-		RequestBody: []byte(b.config.HTTP.Body),
-		N:           b.config.N,
-		C:           b.config.C,
-		H2:          b.config.HTTP.HTTP2,
-		Output:      "",
+	body := []byte(b.config.HTTP.Body)
+	b.worker = newJob(req, body, b.config.C, n, qps, b.config.HTTP.HTTP2)
+	if b.config.Warmup > 0 {
+		n := requestsFor(b.config.QPS, b.config.Warmup, b.config.C)
+		j := newJob(req, body, b.config.C, n, qps, b.config.HTTP.HTTP2)
+		j.Writer = ioutil.Discard
+		b.warmer = j
 	}
 
 	return nil
 }
 
+func (b *Benchmark) initScenario(base string, n int, qps float64) error {
+	var err error
+	if b.worker, err = newScenario(base, b.config, b.config.C, n, qps); err != nil {
+		return err
+	}
+	if b.config.Warmup > 0 {
+		n := requestsFor(b.config.QPS, b.config.Warmup, b.config.C)
+		b.warmer, err = newScenario(base, b.config, b.config.C, n, qps)
+	}
+
+	return err
+}
+
 func (b *Benchmark) annulled() bool {
 	return b.canceled
 }
@@ -85,7 +174,10 @@ func (b *Benchmark) Cancel() {
 		return
 	}
 	b.canceled = true
-	b.worker.Stop()
+	if b.warmer != nil {
+		b.warmer.stop()
+	}
+	b.worker.stop()
 }
 
 // Canceled checks if the benchmark was canceled.
@@ -93,39 +185,129 @@ func (b *Benchmark) Canceled() bool {
 	return b.canceled
 }
 
+// StoppedEarly checks if a timed benchmark ran out of requests before its duration elapsed.
+func (b *Benchmark) StoppedEarly() bool {
+	return b.early
+}
+
 // Run starts a benchmark,
 func (b *Benchmark) Run(cluster string, done func()) {
-	buff := new(bytes.Buffer)
-	b.worker.Writer = buff
-	b.worker.Run()
+	if b.warmer != nil {
+		log.Debug().Msgf("Benchmark warming up for %v", b.config.Warmup)
+		run(b.warmer, b.config.Warmup)
+	}
+	if b.canceled {
+		done()
+		return
+	}
+
+	b.early = run(b.worker, b.config.Duration) && !b.canceled
+	if b.early {
+		log.Warn().Msgf("Benchmark %s exhausted its requests before %v", b.config.Name, b.config.Duration)
+	}
 	if !b.canceled {
-		if err := b.save(cluster, buff); err != nil {
+		if err := b.save(cluster); err != nil {
 			log.Error().Err(err).Msg("Saving Benchmark")
 		}
 	}
 	done()
 }
 
-func (b *Benchmark) save(cluster string, r io.Reader) error {
+// Run executes a workload, stopping it after the given duration if any. It
+// returns true if the workload completed before the duration elapsed.
+func run(w workload, d time.Duration) bool {
+	if d <= 0 {
+		w.Run()
+		return false
+	}
+
+	t := time.AfterFunc(d, w.stop)
+	defer t.Stop()
+	start := time.Now()
+	w.Run()
+
+	return time.Since(start) < d
+}
+
+func (b *Benchmark) save(cluster string) error {
 	dir := filepath.Join(K9sBenchDir, cluster)
 	if err := os.MkdirAll(dir, 0744); err != nil {
 		return err
 	}
 
-	ns, n := resource.Namespaced(b.config.Name)
-	file := filepath.Join(dir, fmt.Sprintf(benchFmat, ns, n, time.Now().UnixNano()))
-	f, err := os.Create(file)
+	rep, text, err := b.worker.results(b.config)
 	if err != nil {
 		return err
 	}
-	defer f.Close()
+	rep.Config, rep.StoppedEarly = redact(rep.Config), b.early
 
-	bb, err := ioutil.ReadAll(r)
+	ns, n := resource.Namespaced(b.config.Name)
+	base := filepath.Join(dir, fmt.Sprintf(benchFmat, ns, n, time.Now().UnixNano()))
+	if err := ioutil.WriteFile(base+ReportExt, text, 0600); err != nil {
+		return err
+	}
+
+	raw, err := json.MarshalIndent(rep, "", "  ")
 	if err != nil {
 		return err
 	}
 
-	f.Write(bb)
+	return ioutil.WriteFile(base+RecordExt, raw, 0600)
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
 
-	return nil
+// WorkerQPS converts a total QPS cap into a per worker rate as hey throttles
+// each worker independently.
+func workerQPS(qps float64, c int) float64 {
+	if qps <= 0 || c <= 0 {
+		return 0
+	}
+
+	return qps / float64(c)
+}
+
+// Redact returns a copy of the benchmark config with its request headers
+// values masked as they may carry credentials.
+func redact(cfg config.BenchConfig) config.BenchConfig {
+	cfg.HTTP.Headers = redactHeaders(cfg.HTTP.Headers)
+	if cfg.Scenario == nil {
+		return cfg
+	}
+	sc := *cfg.Scenario
+	sc.Steps = make([]config.Step, len(cfg.Scenario.Steps))
+	copy(sc.Steps, cfg.Scenario.Steps)
+	for i := range sc.Steps {
+		sc.Steps[i].HTTP.Headers = redactHeaders(sc.Steps[i].HTTP.Headers)
+	}
+	cfg.Scenario = &sc
+
+	return cfg
+}
+
+func redactHeaders(hh http.Header) http.Header {
+	if hh == nil {
+		return nil
+	}
+	res := make(http.Header, len(hh))
+	for k := range hh {
+		res[k] = []string{redacted}
+	}
+
+	return res
+}
+
+// RequestsFor computes the number of requests to issue for a timed run.
+// Unthrottled runs are sized to the max requests and stopped by the timer.
+func requestsFor(qps float64, d time.Duration, c int) int {
+	if qps <= 0 {
+		return maxRequests
+	}
+	n := int(math.Min(math.Ceil(qps*d.Seconds()), maxRequests))
+	if n < c {
+		n = c
+	}
+
+	return n
 }
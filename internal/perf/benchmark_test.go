@@ -0,0 +1,230 @@
+package perf
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWorkerQPS(t *testing.T) {
+	uu := map[string]struct {
+		qps float64
+		c   int
+		e   float64
+	}{
+		"none":    {0, 2, 0},
+		"noC":     {10, 0, 0},
+		"single":  {10, 1, 10},
+		"spread":  {10, 4, 2.5},
+		"negated": {-1, 4, 0},
+	}
+
+	for k, u := range uu {
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, workerQPS(u.qps, u.c))
+		})
+	}
+}
+
+func TestRequestsFor(t *testing.T) {
+	uu := map[string]struct {
+		qps float64
+		d   time.Duration
+		c   int
+		e   int
+	}{
+		"unthrottled": {0, time.Minute, 2, maxRequests},
+		"capped":      {1000, time.Hour, 10, maxRequests},
+		"soak":        {20, 5 * time.Minute, 4, 6000},
+		"fraction":    {0.5, 3 * time.Second, 1, 2},
+		"atLeastC":    {1, time.Second, 10, 10},
+	}
+
+	for k, u := range uu {
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, requestsFor(u.qps, u.d, u.c))
+		})
+	}
+}
+
+func TestRun(t *testing.T) {
+	uu := map[string]struct {
+		w     *fakeWorkload
+		d     time.Duration
+		early bool
+	}{
+		"untimed":   {w: newFakeWorkload(false), d: 0},
+		"timed":     {w: newFakeWorkload(false), d: 50 * time.Millisecond},
+		"exhausted": {w: newFakeWorkload(true), d: time.Second, early: true},
+	}
+
+	for k, u := range uu {
+		t.Run(k, func(t *testing.T) {
+			if u.d == 0 {
+				go u.w.stop()
+			}
+			assert.Equal(t, u.early, run(u.w, u.d))
+		})
+	}
+}
+
+func TestBenchmarkRun(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/toast" {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		fmt.Fprint(w, "ok")
+	}))
+	defer srv.Close()
+
+	dir, err := ioutil.TempDir("", "k9s-bench")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+	K9sBenchDir = dir
+
+	uu := map[string]struct {
+		path     string
+		ok, fail int
+	}{
+		"ok":    {"/", 10, 0},
+		"toast": {"/toast", 0, 10},
+	}
+
+	for k, u := range uu {
+		t.Run(k, func(t *testing.T) {
+			cfg := config.BenchConfig{
+				C:    2,
+				N:    10,
+				Name: "default/" + k,
+				Auth: config.Auth{User: "fred", Password: "blee"},
+				HTTP: config.HTTP{Method: "GET", Headers: http.Header{"Authorization": {"Bearer zorg"}}},
+			}
+			b, err := NewBenchmark(srv.URL+u.path, cfg)
+			assert.Nil(t, err)
+			b.Run("c1", func() {})
+
+			ff, err := filepath.Glob(filepath.Join(dir, "c1", "default_"+k+"_*"+RecordExt))
+			assert.Nil(t, err)
+			assert.Equal(t, 1, len(ff))
+			_, err = os.Stat(strings.TrimSuffix(ff[0], RecordExt) + ReportExt)
+			assert.Nil(t, err)
+
+			r, err := LoadReport(ff[0])
+			assert.Nil(t, err)
+			assert.Equal(t, "default/"+k, r.Name)
+			assert.Equal(t, int64(10), r.Requests)
+			assert.Equal(t, u.ok, r.OK())
+			assert.Equal(t, u.fail, r.Failed())
+			assert.True(t, r.Pass())
+			assert.True(t, r.Percentile(99) > 0)
+			assert.Equal(t, "", r.Config.Auth.Password)
+			assert.Equal(t, redacted, r.Config.HTTP.Headers.Get("Authorization"))
+		})
+	}
+}
+
+func TestRedact(t *testing.T) {
+	cfg := config.BenchConfig{
+		HTTP: config.HTTP{Headers: http.Header{"Authorization": {"Bearer zorg"}}},
+		Scenario: &config.Scenario{Steps: []config.Step{
+			{Name: "login"},
+			{Name: "fetch", HTTP: config.HTTP{Headers: http.Header{"X-Token": {"${token}"}, "Accept": {"a", "b"}}}},
+		}},
+	}
+
+	r := redact(cfg)
+	assert.Equal(t, http.Header{"Authorization": {redacted}}, r.HTTP.Headers)
+	assert.Nil(t, r.Scenario.Steps[0].HTTP.Headers)
+	assert.Equal(t, http.Header{"X-Token": {redacted}, "Accept": {redacted}}, r.Scenario.Steps[1].HTTP.Headers)
+	assert.Equal(t, "Bearer zorg", cfg.HTTP.Headers.Get("Authorization"))
+	assert.Equal(t, "${token}", cfg.Scenario.Steps[1].HTTP.Headers.Get("X-Token"))
+}
+
+func TestPercentiles(t *testing.T) {
+	lats := make([]float64, 0, 200)
+	for i := 200; i > 0; i-- {
+		lats = append(lats, float64(i))
+	}
+
+	uu := map[string]struct {
+		lats []float64
+		p    int
+		e    float64
+	}{
+		"empty":  {nil, 50, 0},
+		"single": {[]float64{0.5}, 99, 0.5},
+		"p50":    {lats, 50, 100},
+		"p95":    {lats, 95, 190},
+		"p99":    {lats, 99, 198},
+	}
+
+	for k, u := range uu {
+		t.Run(k, func(t *testing.T) {
+			r := Report{Latencies: percentiles(u.lats)}
+			assert.Equal(t, u.e, r.Percentile(u.p))
+		})
+	}
+}
+
+func TestTimeline(t *testing.T) {
+	uu := map[string]struct {
+		offsets []float64
+		n       int
+		e       Timeline
+	}{
+		"empty":  {nil, 4, Timeline{}},
+		"single": {[]float64{3, 3}, 4, Timeline{Step: 1, Counts: []int{2}}},
+		"spread": {[]float64{10, 10.5, 11, 12, 14}, 4, Timeline{Step: 1, Counts: []int{2, 1, 1, 1}}},
+		"stall":  {[]float64{1, 1.1, 1.2, 5}, 4, Timeline{Step: 1, Counts: []int{3, 0, 0, 1}}},
+	}
+
+	for k, u := range uu {
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, timeline(u.offsets, u.n))
+		})
+	}
+}
+
+func TestTimelineRates(t *testing.T) {
+	tl := Timeline{Step: 0.5, Counts: []int{1, 0, 4}}
+
+	assert.Equal(t, []float64{2, 0, 8}, tl.Rates())
+	assert.Equal(t, []float64{0}, Timeline{Counts: []int{3}}.Rates())
+}
+
+// Helpers...
+
+type fakeWorkload struct {
+	done     bool
+	stopChan chan struct{}
+	once     sync.Once
+}
+
+func newFakeWorkload(done bool) *fakeWorkload {
+	return &fakeWorkload{done: done, stopChan: make(chan struct{})}
+}
+
+func (f *fakeWorkload) Run() {
+	if !f.done {
+		<-f.stopChan
+	}
+}
+
+func (f *fakeWorkload) stop() {
+	f.once.Do(func() { close(f.stopChan) })
+}
+
+func (f *fakeWorkload) results(config.BenchConfig) (Report, []byte, error) {
+	return Report{}, nil, nil
+}
@@ -0,0 +1,340 @@
+package perf
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"math"
+	"sort"
+	"strings"
+	"text/template"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/rakyll/hey/requester"
+)
+
+const (
+	// ReportExt the benchmark text report extension.
+	ReportExt = ".txt"
+	// RecordExt the benchmark record extension.
+	RecordExt = ".json"
+
+	// JsonTmpl instructs hey to dump its raw results as json.
+	jsonTmpl = `{{ jsonify . }}`
+	barChar  = "■"
+	// TimelineSlots the maximum number of time slices tracked by a run timeline.
+	timelineSlots = 60
+)
+
+var pctls = []int{10, 25, 50, 75, 90, 95, 99}
+
+type (
+	// Report represents a benchmark run record.
+	Report struct {
+		Name         string             `json:"name"`
+		Config       config.BenchConfig `json:"config"`
+		Total        float64            `json:"total"`
+		Requests     int64              `json:"requests"`
+		RPS          float64            `json:"rps"`
+		Fastest      float64            `json:"fastest"`
+		Slowest      float64            `json:"slowest"`
+		Average      float64            `json:"average"`
+		SizeTotal    int64              `json:"sizeTotal"`
+		Latencies    []Latency          `json:"latencies"`
+		Histogram    []Bucket           `json:"histogram"`
+		StatusCodes  map[int]int        `json:"statusCodes"`
+		Errors       map[string]int     `json:"errors,omitempty"`
+		Timeline     Timeline           `json:"timeline"`
+		Steps        []StepReport       `json:"steps,omitempty"`
+		StoppedEarly bool               `json:"stoppedEarly,omitempty"`
+	}
+
+	// StepReport represents a scenario step stats.
+	StepReport struct {
+		Name        string         `json:"name"`
+		Requests    int64          `json:"requests"`
+		RPS         float64        `json:"rps"`
+		Average     float64        `json:"average"`
+		Latencies   []Latency      `json:"latencies"`
+		StatusCodes map[int]int    `json:"statusCodes"`
+		Errors      map[string]int `json:"errors,omitempty"`
+	}
+
+	// Timeline tracks the number of completed requests per time slice.
+	Timeline struct {
+		Step   float64 `json:"step"`
+		Counts []int   `json:"counts"`
+	}
+
+	// Latency represents a latency percentile in seconds.
+	Latency struct {
+		Percentile int     `json:"percentile"`
+		Secs       float64 `json:"secs"`
+	}
+
+	// Bucket represents a latency histogram bucket.
+	Bucket struct {
+		Mark  float64 `json:"mark"`
+		Count int     `json:"count"`
+	}
+)
+
+// NewReport returns a new benchmark record from hey results.
+func NewReport(cfg config.BenchConfig, r requester.Report) Report {
+	rep := Report{
+		Name:        cfg.Name,
+		Config:      cfg,
+		Total:       r.Total.Seconds(),
+		Requests:    r.NumRes,
+		RPS:         r.Rps,
+		Fastest:     r.Fastest,
+		Slowest:     r.Slowest,
+		Average:     r.Average,
+		SizeTotal:   r.SizeTotal,
+		StatusCodes: r.StatusCodeDist,
+		Errors:      r.ErrorDist,
+	}
+	rep.Latencies = percentiles(r.Lats)
+	rep.Timeline = timeline(r.Offsets, timelineSlots)
+	for _, b := range r.Histogram {
+		rep.Histogram = append(rep.Histogram, Bucket{Mark: b.Mark, Count: b.Count})
+	}
+
+	return rep
+}
+
+// LoadReport loads a benchmark record from disk.
+func LoadReport(path string) (Report, error) {
+	var r Report
+	raw, err := ioutil.ReadFile(path)
+	if err != nil {
+		return r, err
+	}
+	err = json.Unmarshal(raw, &r)
+
+	return r, err
+}
+
+// Pass checks if the benchmark ran without errors.
+func (r Report) Pass() bool {
+	return len(r.Errors) == 0
+}
+
+// Percentile returns the latency for a given percentile or 0 if not known.
+func (r Report) Percentile(p int) float64 {
+	return percentile(r.Latencies, p)
+}
+
+// OK returns the number of 2XX responses.
+func (r Report) OK() int {
+	return codes(r.StatusCodes, 200, 300)
+}
+
+// Failed returns the number of 4XX and 5XX responses.
+func (r Report) Failed() int {
+	return codes(r.StatusCodes, 400, 600)
+}
+
+// ErrorRate returns the ratio of failed requests, including 4XX/5XX responses.
+func (r Report) ErrorRate() float64 {
+	if r.Requests == 0 {
+		return 0
+	}
+	errs := r.Failed()
+	for _, n := range r.Errors {
+		errs += n
+	}
+
+	return float64(errs) / float64(r.Requests)
+}
+
+// Percentile returns the step latency for a given percentile or 0 if not known.
+func (s StepReport) Percentile(p int) float64 {
+	return percentile(s.Latencies, p)
+}
+
+// OK returns the number of step 2XX responses.
+func (s StepReport) OK() int {
+	return codes(s.StatusCodes, 200, 300)
+}
+
+// Failed returns the number of step 4XX and 5XX responses.
+func (s StepReport) Failed() int {
+	return codes(s.StatusCodes, 400, 600)
+}
+
+// ErrorCount returns the number of step requests that did not get a response.
+func (s StepReport) ErrorCount() int {
+	var sum int
+	for _, n := range s.Errors {
+		sum += n
+	}
+
+	return sum
+}
+
+// Rates returns the request rate per second for each time slice.
+func (t Timeline) Rates() []float64 {
+	rr := make([]float64, len(t.Counts))
+	if t.Step == 0 {
+		return rr
+	}
+	for i, c := range t.Counts {
+		rr[i] = float64(c) / t.Step
+	}
+
+	return rr
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// Percentiles computes nearest rank latency percentiles. Hey only reports high
+// percentiles once enough requests were issued.
+func percentiles(lats []float64) []Latency {
+	if len(lats) == 0 {
+		return nil
+	}
+	ll := make([]float64, len(lats))
+	copy(ll, lats)
+	sort.Float64s(ll)
+
+	res := make([]Latency, 0, len(pctls))
+	for _, p := range pctls {
+		i := int(math.Ceil(float64(p)/100*float64(len(ll)))) - 1
+		if i < 0 {
+			i = 0
+		}
+		res = append(res, Latency{Percentile: p, Secs: ll[i]})
+	}
+
+	return res
+}
+
+// Timeline buckets request offsets into at most n equal time slices.
+func timeline(offsets []float64, n int) Timeline {
+	if len(offsets) == 0 || n <= 0 {
+		return Timeline{}
+	}
+
+	min, max := offsets[0], offsets[0]
+	for _, o := range offsets {
+		min, max = math.Min(min, o), math.Max(max, o)
+	}
+	span := max - min
+	if span == 0 {
+		return Timeline{Step: 1, Counts: []int{len(offsets)}}
+	}
+
+	t := Timeline{Step: span / float64(n), Counts: make([]int, n)}
+	for _, o := range offsets {
+		i := int((o - min) / t.Step)
+		if i >= n {
+			i = n - 1
+		}
+		t.Counts[i]++
+	}
+
+	return t
+}
+
+func percentile(ll []Latency, p int) float64 {
+	for _, l := range ll {
+		if l.Percentile == p {
+			return l.Secs
+		}
+	}
+
+	return 0
+}
+
+func codes(cc map[int]int, from, to int) int {
+	var sum int
+	for c, n := range cc {
+		if c >= from && c < to {
+			sum += n
+		}
+	}
+
+	return sum
+}
+
+func readResults(r io.Reader) (requester.Report, error) {
+	var rep requester.Report
+	err := json.NewDecoder(r).Decode(&rep)
+
+	return rep, err
+}
+
+func writeText(w io.Writer, r requester.Report) error {
+	return textTmpl.Execute(w, r)
+}
+
+func histogram(buckets []requester.Bucket) string {
+	var max int
+	for _, b := range buckets {
+		if b.Count > max {
+			max = b.Count
+		}
+	}
+	res := new(bytes.Buffer)
+	for _, b := range buckets {
+		var barLen int
+		if max > 0 {
+			barLen = (b.Count*40 + max/2) / max
+		}
+		fmt.Fprintf(res, "  %4.3f [%v]\t|%v\n", b.Mark, b.Count, strings.Repeat(barChar, barLen))
+	}
+
+	return res.String()
+}
+
+func bucketHistogram(bb []Bucket) string {
+	buckets := make([]requester.Bucket, 0, len(bb))
+	for _, b := range bb {
+		buckets = append(buckets, requester.Bucket{Mark: b.Mark, Count: b.Count})
+	}
+
+	return histogram(buckets)
+}
+
+func formatNumber(f float64) string {
+	return fmt.Sprintf("%4.4f", f)
+}
+
+// TextTmpl renders hey's standard summary report.
+var textTmpl = template.Must(template.New("report").Funcs(template.FuncMap{
+	"formatNumber": formatNumber,
+	"histogram":    histogram,
+}).Parse(`
+Summary:
+  Total:	{{ formatNumber .Total.Seconds }} secs
+  Slowest:	{{ formatNumber .Slowest }} secs
+  Fastest:	{{ formatNumber .Fastest }} secs
+  Average:	{{ formatNumber .Average }} secs
+  Requests/sec:	{{ formatNumber .Rps }}
+  {{ if gt .SizeTotal 0 }}
+  Total data:	{{ .SizeTotal }} bytes
+  Size/request:	{{ .SizeReq }} bytes{{ end }}
+
+Response time histogram:
+{{ histogram .Histogram }}
+
+Latency distribution:{{ range .LatencyDistribution }}
+  {{ .Percentage }}% in {{ formatNumber .Latency }} secs{{ end }}
+
+Details (average, fastest, slowest):
+  DNS+dialup:	{{ formatNumber .AvgConn }} secs, {{ formatNumber .Fastest }} secs, {{ formatNumber .Slowest }} secs
+  DNS-lookup:	{{ formatNumber .AvgDNS }} secs, {{ formatNumber .DnsMax }} secs, {{ formatNumber .DnsMin }} secs
+  req write:	{{ formatNumber .AvgReq }} secs, {{ formatNumber .ReqMax }} secs, {{ formatNumber .ReqMin }} secs
+  resp wait:	{{ formatNumber .AvgDelay }} secs, {{ formatNumber .DelayMax }} secs, {{ formatNumber .DelayMin }} secs
+  resp read:	{{ formatNumber .AvgRes }} secs, {{ formatNumber .ResMax }} secs, {{ formatNumber .ResMin }} secs
+
+Status code distribution:{{ range $code, $num := .StatusCodeDist }}
+  [{{ $code }}]	{{ $num }} responses{{ end }}
+
+{{ if gt (len .ErrorDist) 0 }}Error distribution:{{ range $err, $num := .ErrorDist }}
+  [{{ $num }}]	{{ $err }}{{ end }}{{ end }}
+`))
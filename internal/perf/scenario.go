@@ -0,0 +1,498 @@
+package perf
+
+import (
+	"bytes"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"math/rand"
+	"net/http"
+	"net/url"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"text/template"
+	"time"
+
+	"github.com/derailed/k9s/internal/config"
+)
+
+const requestTimeout = 20 * time.Second
+
+var varRX = regexp.MustCompile(`\$\{(\w+)\}`)
+
+type (
+	// Scenario runs a sequence of requests, feeding captured values from
+	// earlier responses into later requests.
+	scenario struct {
+		steps    []config.Step
+		weighted bool
+		base     *url.URL
+		auth     config.Auth
+		headers  http.Header
+		client   *http.Client
+		c, n     int
+		qps      float64
+		start    time.Time
+		elapsed  time.Duration
+		stopChan chan struct{}
+		once     sync.Once
+		mx       sync.Mutex
+		samples  []sample
+		dropped  int64
+	}
+
+	// Sample tracks a scenario request outcome.
+	sample struct {
+		step   int
+		offset float64
+		lat    float64
+		code   int
+		size   int64
+		err    string
+	}
+)
+
+func newScenario(base string, cfg config.BenchConfig, c, n int, qps float64) (*scenario, error) {
+	u, err := url.Parse(base)
+	if err != nil {
+		return nil, err
+	}
+	if len(cfg.Scenario.Steps) == 0 {
+		return nil, fmt.Errorf("Scenario %s has no steps", cfg.Name)
+	}
+
+	s := scenario{
+		steps:    make([]config.Step, len(cfg.Scenario.Steps)),
+		weighted: cfg.Scenario.Weighted,
+		base:     u,
+		auth:     cfg.Auth,
+		headers:  cfg.HTTP.Headers,
+		client: &http.Client{
+			Timeout: requestTimeout,
+			// Match hey's transport which skips certs verification.
+			Transport: &http.Transport{
+				TLSClientConfig:     &tls.Config{InsecureSkipVerify: true},
+				MaxIdleConnsPerHost: c,
+				ForceAttemptHTTP2:   cfg.HTTP.HTTP2,
+			},
+		},
+		c:        c,
+		n:        n,
+		qps:      qps,
+		stopChan: make(chan struct{}),
+	}
+	copy(s.steps, cfg.Scenario.Steps)
+	for i := range s.steps {
+		if s.steps[i].Name == "" {
+			s.steps[i].Name = "step-" + strconv.Itoa(i+1)
+		}
+		if s.steps[i].HTTP.Method == "" {
+			s.steps[i].HTTP.Method = config.DefaultMethod
+		}
+	}
+
+	return &s, nil
+}
+
+// Run executes the scenario until all requests are issued or it is stopped.
+func (s *scenario) Run() {
+	s.start = time.Now()
+	var wg sync.WaitGroup
+	wg.Add(s.c)
+	for i := 0; i < s.c; i++ {
+		n := s.n / s.c
+		if i < s.n%s.c {
+			n++
+		}
+		go func(n int, seed int64) {
+			defer wg.Done()
+			s.work(n, rand.New(rand.NewSource(seed)))
+		}(n, s.start.UnixNano()+int64(i))
+	}
+	wg.Wait()
+	s.elapsed = time.Since(s.start)
+}
+
+func (s *scenario) stop() {
+	s.once.Do(func() { close(s.stopChan) })
+}
+
+func (s *scenario) results(cfg config.BenchConfig) (Report, []byte, error) {
+	s.mx.Lock()
+	defer s.mx.Unlock()
+
+	r := newScenarioReport(cfg, s.steps, s.samples, s.dropped, s.elapsed)
+	buff := new(bytes.Buffer)
+	err := scenarioTmpl.Execute(buff, r)
+
+	return r, buff.Bytes(), err
+}
+
+// Work issues n requests unless the scenario is stopped. Captured values are
+// scoped to the worker.
+func (s *scenario) work(n int, rnd *rand.Rand) {
+	var throttle <-chan time.Time
+	if s.qps > 0 {
+		t := time.NewTicker(time.Duration(1e6/s.qps) * time.Microsecond)
+		defer t.Stop()
+		throttle = t.C
+	}
+
+	vars := make(map[string]string)
+	setup, mix, total := s.plan()
+	for i := 0; i < n; i++ {
+		if throttle != nil {
+			select {
+			case <-throttle:
+			case <-s.stopChan:
+				return
+			}
+		}
+		select {
+		case <-s.stopChan:
+			return
+		default:
+		}
+
+		var step int
+		switch {
+		case i < len(setup):
+			step = setup[i]
+		case total > 0:
+			step = pick(mix, s.steps, rnd.Intn(total))
+		default:
+			step = i % len(s.steps)
+		}
+		s.record(s.do(step, vars))
+	}
+}
+
+// Plan returns the setup steps and weighted steps along with their total
+// weight. Unweighted scenarios cycle through all their steps.
+func (s *scenario) plan() (setup, mix []int, total int) {
+	if !s.weighted {
+		return nil, nil, 0
+	}
+	for i, st := range s.steps {
+		if st.Weight <= 0 {
+			setup = append(setup, i)
+			continue
+		}
+		mix, total = append(mix, i), total+st.Weight
+	}
+	if total == 0 {
+		return nil, nil, 0
+	}
+
+	return setup, mix, total
+}
+
+func (s *scenario) do(step int, vars map[string]string) sample {
+	st := s.steps[step]
+	t := time.Now()
+	smp := sample{step: step, offset: t.Sub(s.start).Seconds()}
+
+	req, err := s.request(st.HTTP, vars)
+	if err != nil {
+		smp.err = err.Error()
+		return smp
+	}
+	resp, err := s.client.Do(req)
+	if err != nil {
+		smp.err = err.Error()
+		return smp
+	}
+	defer resp.Body.Close()
+	body, err := ioutil.ReadAll(resp.Body)
+	smp.lat = time.Since(t).Seconds()
+	if err != nil {
+		smp.err = err.Error()
+		return smp
+	}
+	smp.code, smp.size = resp.StatusCode, int64(len(body))
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return smp
+	}
+
+	for _, c := range st.Capture {
+		v, err := capture(c, resp.Header, body)
+		if err != nil {
+			smp.code, smp.err = 0, err.Error()
+			return smp
+		}
+		vars[c.Name] = v
+	}
+
+	return smp
+}
+
+func (s *scenario) request(h config.HTTP, vars map[string]string) (*http.Request, error) {
+	ref, err := url.Parse(expand(h.Path, vars))
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequest(h.Method, s.base.ResolveReference(ref).String(), strings.NewReader(expand(h.Body, vars)))
+	if err != nil {
+		return nil, err
+	}
+	for _, hh := range []http.Header{s.headers, h.Headers} {
+		for k, vv := range hh {
+			req.Header.Del(k)
+			for _, v := range vv {
+				req.Header.Add(k, expand(v, vars))
+			}
+		}
+	}
+	if h.Host != "" {
+		req.Host = h.Host
+	}
+	if s.auth.User != "" || s.auth.Password != "" {
+		req.SetBasicAuth(s.auth.User, s.auth.Password)
+	}
+	ua := req.UserAgent()
+	if ua == "" {
+		ua = k9sUA
+	} else {
+		ua += " " + k9sUA
+	}
+	req.Header.Set("User-Agent", ua)
+
+	return req, nil
+}
+
+func (s *scenario) record(smp sample) {
+	s.mx.Lock()
+	defer s.mx.Unlock()
+
+	if len(s.samples) >= maxRequests {
+		s.dropped++
+		return
+	}
+	s.samples = append(s.samples, smp)
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// Pick returns the weighted step matching the given roll.
+func pick(mix []int, steps []config.Step, roll int) int {
+	for _, i := range mix {
+		if roll < steps[i].Weight {
+			return i
+		}
+		roll -= steps[i].Weight
+	}
+
+	return mix[len(mix)-1]
+}
+
+// Expand substitutes ${name} references with captured values. Unknown
+// variables are left untouched.
+func expand(s string, vars map[string]string) string {
+	if len(vars) == 0 {
+		return s
+	}
+
+	return varRX.ReplaceAllStringFunc(s, func(m string) string {
+		if v, ok := vars[m[2:len(m)-1]]; ok {
+			return v
+		}
+		return m
+	})
+}
+
+// Capture extracts a value from a response header or json body.
+func capture(c config.Capture, h http.Header, body []byte) (string, error) {
+	if c.Header != "" {
+		v := h.Get(c.Header)
+		if v == "" {
+			return "", fmt.Errorf("capture %s: no header %s", c.Name, c.Header)
+		}
+		return v, nil
+	}
+
+	d := json.NewDecoder(bytes.NewReader(body))
+	d.UseNumber()
+	var doc interface{}
+	if err := d.Decode(&doc); err != nil {
+		return "", fmt.Errorf("capture %s: %s", c.Name, err)
+	}
+	v, ok := jsonPath(doc, c.JSON)
+	if !ok {
+		return "", fmt.Errorf("capture %s: no json field %s", c.Name, c.JSON)
+	}
+
+	return v, nil
+}
+
+// JSONPath walks a dot separated path, ie data.items.0.id, down a json
+// document and returns the matching value as a string.
+func jsonPath(doc interface{}, path string) (string, bool) {
+	for _, k := range strings.Split(path, ".") {
+		if k == "" {
+			continue
+		}
+		switch n := doc.(type) {
+		case map[string]interface{}:
+			v, ok := n[k]
+			if !ok {
+				return "", false
+			}
+			doc = v
+		case []interface{}:
+			i, err := strconv.Atoi(k)
+			if err != nil || i < 0 || i >= len(n) {
+				return "", false
+			}
+			doc = n[i]
+		default:
+			return "", false
+		}
+	}
+
+	switch v := doc.(type) {
+	case nil:
+		return "", false
+	case string:
+		return v, true
+	case json.Number, bool:
+		return fmt.Sprintf("%v", v), true
+	default:
+		raw, err := json.Marshal(v)
+		return string(raw), err == nil
+	}
+}
+
+// Histogram buckets sorted latencies the same way hey does.
+func histogramOf(lats []float64) []Bucket {
+	if len(lats) == 0 {
+		return nil
+	}
+
+	const bc = 10
+	fastest, slowest := lats[0], lats[len(lats)-1]
+	bs := (slowest - fastest) / bc
+	bb := make([]Bucket, bc+1)
+	for i := 0; i < bc; i++ {
+		bb[i].Mark = fastest + bs*float64(i)
+	}
+	bb[bc].Mark = slowest
+
+	var bi int
+	for i := 0; i < len(lats); {
+		if lats[i] <= bb[bi].Mark {
+			bb[bi].Count++
+			i++
+			continue
+		}
+		if bi < len(bb)-1 {
+			bi++
+		}
+	}
+
+	return bb
+}
+
+// NewScenarioReport builds a scenario run record. Like hey, stats past the
+// first maxRequests samples only contribute to the requests count and rate.
+func newScenarioReport(cfg config.BenchConfig, steps []config.Step, ss []sample, dropped int64, total time.Duration) Report {
+	r := Report{
+		Name:        cfg.Name,
+		Config:      cfg,
+		Total:       total.Seconds(),
+		Requests:    int64(len(ss)) + dropped,
+		StatusCodes: make(map[int]int),
+		Steps:       make([]StepReport, len(steps)),
+	}
+	if total > 0 {
+		r.RPS = float64(r.Requests) / total.Seconds()
+	}
+	for i, st := range steps {
+		r.Steps[i] = StepReport{Name: st.Name, StatusCodes: make(map[int]int)}
+	}
+
+	var lats, offsets []float64
+	stepLats := make([][]float64, len(steps))
+	for _, s := range ss {
+		st := &r.Steps[s.step]
+		st.Requests++
+		if s.err != "" {
+			if r.Errors == nil {
+				r.Errors = make(map[string]int)
+			}
+			if st.Errors == nil {
+				st.Errors = make(map[string]int)
+			}
+			r.Errors[s.err]++
+			st.Errors[s.err]++
+			continue
+		}
+		r.StatusCodes[s.code]++
+		st.StatusCodes[s.code]++
+		r.SizeTotal += s.size
+		lats, offsets = append(lats, s.lat), append(offsets, s.offset)
+		stepLats[s.step] = append(stepLats[s.step], s.lat)
+	}
+
+	sort.Float64s(lats)
+	if len(lats) > 0 {
+		r.Fastest, r.Slowest, r.Average = lats[0], lats[len(lats)-1], average(lats)
+	}
+	r.Latencies, r.Histogram = percentiles(lats), histogramOf(lats)
+	r.Timeline = timeline(offsets, timelineSlots)
+	for i, ll := range stepLats {
+		st := &r.Steps[i]
+		if total > 0 {
+			st.RPS = float64(st.Requests) / total.Seconds()
+		}
+		st.Average, st.Latencies = average(ll), percentiles(ll)
+	}
+
+	return r
+}
+
+func average(ll []float64) float64 {
+	if len(ll) == 0 {
+		return 0
+	}
+	var sum float64
+	for _, l := range ll {
+		sum += l
+	}
+
+	return sum / float64(len(ll))
+}
+
+// ScenarioTmpl renders a scenario run summary report.
+var scenarioTmpl = template.Must(template.New("scenario").Funcs(template.FuncMap{
+	"formatNumber": formatNumber,
+	"histogram":    bucketHistogram,
+}).Parse(`
+Summary:
+  Total:	{{ formatNumber .Total }} secs
+  Slowest:	{{ formatNumber .Slowest }} secs
+  Fastest:	{{ formatNumber .Fastest }} secs
+  Average:	{{ formatNumber .Average }} secs
+  Requests/sec:	{{ formatNumber .RPS }}
+  {{ if gt .SizeTotal 0 }}
+  Total data:	{{ .SizeTotal }} bytes{{ end }}
+
+Response time histogram:
+{{ histogram .Histogram }}
+
+Latency distribution:{{ range .Latencies }}
+  {{ .Percentile }}% in {{ formatNumber .Secs }} secs{{ end }}
+
+Steps (requests, requests/sec, average, 2XX, 4XX/5XX, errors):{{ range .Steps }}
+  {{ .Name }}:	{{ .Requests }}, {{ formatNumber .RPS }}, {{ formatNumber .Average }} secs, {{ .OK }}, {{ .Failed }}, {{ .ErrorCount }}{{ end }}
+
+Status code distribution:{{ range $code, $num := .StatusCodes }}
+  [{{ $code }}]	{{ $num }} responses{{ end }}
+
+{{ if gt (len .Errors) 0 }}Error distribution:{{ range $err, $num := .Errors }}
+  [{{ $num }}]	{{ $err }}{{ end }}{{ end }}
+`))
@@ -0,0 +1,280 @@
+package perf
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExpand(t *testing.T) {
+	vars := map[string]string{"token": "abc", "id": "7"}
+	uu := map[string]struct {
+		s, e string
+	}{
+		"none":    {"/orders", "/orders"},
+		"single":  {"Bearer ${token}", "Bearer abc"},
+		"many":    {"/orders/${id}?t=${token}", "/orders/7?t=abc"},
+		"unknown": {"/users/${user}", "/users/${user}"},
+		"dollar":  {"$token", "$token"},
+	}
+
+	for k, u := range uu {
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, expand(u.s, vars))
+		})
+	}
+}
+
+func TestJSONPath(t *testing.T) {
+	raw := `{"data": {"token": "abc", "count": 3, "ok": true, "items": [{"id": 10}, {"id": 20}], "meta": {"a": 1}, "none": null}}`
+	var doc interface{}
+	d := json.NewDecoder(strings.NewReader(raw))
+	d.UseNumber()
+	assert.Nil(t, d.Decode(&doc))
+
+	uu := map[string]struct {
+		path string
+		e    string
+		ok   bool
+	}{
+		"string":  {"data.token", "abc", true},
+		"number":  {"data.count", "3", true},
+		"bool":    {"data.ok", "true", true},
+		"index":   {"data.items.1.id", "20", true},
+		"object":  {"data.meta", `{"a":1}`, true},
+		"null":    {"data.none", "", false},
+		"missing": {"data.blee", "", false},
+		"badIdx":  {"data.items.5.id", "", false},
+		"scalar":  {"data.token.blee", "", false},
+	}
+
+	for k, u := range uu {
+		t.Run(k, func(t *testing.T) {
+			v, ok := jsonPath(doc, u.path)
+			assert.Equal(t, u.ok, ok)
+			assert.Equal(t, u.e, v)
+		})
+	}
+}
+
+func TestCapture(t *testing.T) {
+	h := http.Header{"X-Session": []string{"s1"}}
+	body := []byte(`{"token": "abc"}`)
+	uu := map[string]struct {
+		c   config.Capture
+		e   string
+		err bool
+	}{
+		"header":     {c: config.Capture{Name: "s", Header: "x-session"}, e: "s1"},
+		"json":       {c: config.Capture{Name: "t", JSON: "token"}, e: "abc"},
+		"noHeader":   {c: config.Capture{Name: "s", Header: "X-Blee"}, err: true},
+		"noJSONPath": {c: config.Capture{Name: "t", JSON: "blee"}, err: true},
+	}
+
+	for k, u := range uu {
+		t.Run(k, func(t *testing.T) {
+			v, err := capture(u.c, h, body)
+			assert.Equal(t, u.err, err != nil)
+			assert.Equal(t, u.e, v)
+		})
+	}
+	_, err := capture(config.Capture{Name: "t", JSON: "token"}, h, []byte("<html/>"))
+	assert.NotNil(t, err)
+}
+
+func TestPick(t *testing.T) {
+	steps := []config.Step{{Weight: 0}, {Weight: 3}, {Weight: 1}}
+	mix := []int{1, 2}
+
+	assert.Equal(t, 1, pick(mix, steps, 0))
+	assert.Equal(t, 1, pick(mix, steps, 2))
+	assert.Equal(t, 2, pick(mix, steps, 3))
+}
+
+func TestHistogramOf(t *testing.T) {
+	bb := histogramOf([]float64{1, 1, 2, 11})
+
+	assert.Equal(t, 11, len(bb))
+	assert.Equal(t, Bucket{Mark: 1, Count: 2}, bb[0])
+	assert.Equal(t, Bucket{Mark: 2, Count: 1}, bb[1])
+	assert.Equal(t, Bucket{Mark: 11, Count: 1}, bb[10])
+	assert.Nil(t, histogramOf(nil))
+}
+
+func TestScenarioRun(t *testing.T) {
+	srv := httptest.NewServer(scenarioHandler())
+	defer srv.Close()
+
+	dir, err := ioutil.TempDir("", "k9s-scenario")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+	K9sBenchDir = dir
+
+	login := config.Step{
+		Name: "login",
+		HTTP: config.HTTP{Method: "POST", Path: "/login"},
+		Capture: []config.Capture{
+			{Name: "token", JSON: "data.token"},
+			{Name: "session", Header: "X-Session"},
+		},
+	}
+	orders := config.Step{
+		Name: "orders",
+		HTTP: config.HTTP{
+			Path:    "/orders/${session}",
+			Headers: http.Header{"Authorization": []string{"Bearer ${token}"}},
+		},
+	}
+	health := config.Step{HTTP: config.HTTP{Path: "/health"}}
+
+	uu := map[string]struct {
+		scenario config.Scenario
+		n        int
+		e        map[string]int64
+	}{
+		"ordered": {
+			scenario: config.Scenario{Steps: []config.Step{login, orders}},
+			n:        12,
+			e:        map[string]int64{"login": 6, "orders": 6},
+		},
+		"weighted": {
+			scenario: config.Scenario{
+				Weighted: true,
+				Steps:    []config.Step{login, withWeight(orders, 3), withWeight(health, 1)},
+			},
+			n: 20,
+			e: map[string]int64{"login": 2},
+		},
+	}
+
+	for k, u := range uu {
+		t.Run(k, func(t *testing.T) {
+			cfg := config.BenchConfig{
+				C:        2,
+				N:        u.n,
+				Name:     "default/" + k,
+				Scenario: &u.scenario,
+			}
+			b, err := NewBenchmark(srv.URL+"/", cfg)
+			assert.Nil(t, err)
+			b.Run("c1", func() {})
+
+			ff, err := filepath.Glob(filepath.Join(dir, "c1", "default_"+k+"_*"+RecordExt))
+			assert.Nil(t, err)
+			assert.Equal(t, 1, len(ff))
+			text, err := ioutil.ReadFile(strings.TrimSuffix(ff[0], RecordExt) + ReportExt)
+			assert.Nil(t, err)
+			assert.Contains(t, string(text), "login:")
+
+			r, err := LoadReport(ff[0])
+			assert.Nil(t, err)
+			assert.Equal(t, int64(u.n), r.Requests)
+			assert.Equal(t, u.n, r.OK())
+			assert.True(t, r.Pass())
+			assert.Equal(t, len(u.scenario.Steps), len(r.Steps))
+			var total int64
+			for _, s := range r.Steps {
+				total += s.Requests
+				assert.Equal(t, int(s.Requests), s.OK())
+				if e, ok := u.e[s.Name]; ok {
+					assert.Equal(t, e, s.Requests)
+				}
+			}
+			assert.Equal(t, int64(u.n), total)
+		})
+	}
+}
+
+func TestScenarioCaptureFailed(t *testing.T) {
+	srv := httptest.NewServer(scenarioHandler())
+	defer srv.Close()
+
+	s, err := newScenario(srv.URL, config.BenchConfig{
+		Scenario: &config.Scenario{Steps: []config.Step{
+			{Name: "health", HTTP: config.HTTP{Path: "/health"}, Capture: []config.Capture{{Name: "t", JSON: "token"}}},
+		}},
+	}, 1, 2, 0)
+	assert.Nil(t, err)
+	s.Run()
+
+	r, _, err := s.results(config.BenchConfig{})
+	assert.Nil(t, err)
+	assert.Equal(t, int64(2), r.Requests)
+	assert.Equal(t, 0, r.OK())
+	assert.Equal(t, 2, r.Steps[0].ErrorCount())
+	assert.Equal(t, 1.0, r.ErrorRate())
+}
+
+func TestScenarioSelfSigned(t *testing.T) {
+	srv := httptest.NewTLSServer(scenarioHandler())
+	defer srv.Close()
+
+	s, err := newScenario(srv.URL, config.BenchConfig{
+		Scenario: &config.Scenario{Steps: []config.Step{{HTTP: config.HTTP{Path: "/health"}}}},
+	}, 1, 2, 0)
+	assert.Nil(t, err)
+	s.Run()
+
+	r, _, err := s.results(config.BenchConfig{})
+	assert.Nil(t, err)
+	assert.Equal(t, 2, r.OK())
+	assert.True(t, r.Pass())
+}
+
+func TestScenarioStop(t *testing.T) {
+	srv := httptest.NewServer(scenarioHandler())
+	defer srv.Close()
+
+	s, err := newScenario(srv.URL, config.BenchConfig{
+		Scenario: &config.Scenario{Steps: []config.Step{{HTTP: config.HTTP{Path: "/health"}}}},
+	}, 2, requestsFor(0, time.Second, 2), 0)
+	assert.Nil(t, err)
+	run(s, 50*time.Millisecond)
+	s.stop()
+
+	r, _, err := s.results(config.BenchConfig{})
+	assert.Nil(t, err)
+	assert.True(t, r.Requests > 0)
+	assert.Equal(t, "step-1", r.Steps[0].Name)
+}
+
+// Helpers...
+
+func withWeight(s config.Step, w int) config.Step {
+	s.Weight = w
+	return s
+}
+
+func scenarioHandler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/login", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+		w.Header().Set("X-Session", "s1")
+		fmt.Fprint(w, `{"data": {"token": "abc"}}`)
+	})
+	mux.HandleFunc("/orders/s1", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer abc" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		fmt.Fprint(w, `[]`)
+	})
+	mux.HandleFunc("/health", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, "ok")
+	})
+
+	return mux
+}
@@ -9,7 +9,6 @@ import (
 	"github.com/derailed/k9s/internal/k8s"
 	"github.com/derailed/k9s/internal/watch"
 	"github.com/rs/zerolog/log"
-	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	genericprinters "k8s.io/cli-runtime/pkg/printers"
@@ -36,6 +35,43 @@ type (
 		Restart(ns string, name string) error
 	}
 
+	// Rollbackable represents a Kubernetes resource with a rollout history.
+	Rollbackable interface {
+		History(ns string, name string) ([]k8s.Revision, error)
+		Rollback(ns string, name string, revision int64) error
+	}
+
+	// Pausable represents a Kubernetes resource which rollout can be paused.
+	Pausable interface {
+		IsPaused(ns string, name string) (bool, error)
+		Pause(ns string, name string) error
+		Resume(ns string, name string) error
+	}
+
+	// Suspendable represents a Kubernetes resource which schedule can be suspended.
+	Suspendable interface {
+		IsSuspended(ns string, name string) (bool, error)
+		Suspend(ns string, name string) error
+		Resume(ns string, name string) error
+	}
+
+	// Trackable represents a Kubernetes resource which rollout can be tracked.
+	Trackable interface {
+		RolloutStatus() k8s.RolloutStatus
+	}
+
+	// ImageSetter represents a Kubernetes resource which container images can be updated.
+	ImageSetter interface {
+		Images(ns string, name string) ([]k8s.ContainerImage, error)
+		SetImages(ns string, name string, images []k8s.ContainerImage) error
+	}
+
+	// Drainable represents a cordonable and drainable Kubernetes node.
+	Drainable interface {
+		Cordon(name string, cordon bool) error
+		Drain(name string, opts k8s.DrainOptions, fn k8s.DrainFn) error
+	}
+
 	// Connection represents a Kubenetes apiserver connection.
 	Connection k8s.Connection
 
@@ -164,25 +200,15 @@ func (*Base) marshalObject(o runtime.Object) (string, error) {
 
 func (b *Base) podLogs(ctx context.Context, c chan<- string, sel map[string]string, opts LogOptions) error {
 	i := ctx.Value(IKey("informer")).(*watch.Informer)
-	pods, err := i.List(watch.PodIndex, opts.Namespace, metav1.ListOptions{
-		LabelSelector: toSelector(sel),
-	})
-	if err != nil {
+	lsel := toSelector(sel)
+	if _, err := i.List(watch.PodIndex, opts.Namespace, metav1.ListOptions{LabelSelector: lsel}); err != nil {
 		return err
 	}
 
-	if len(pods) > 1 {
-		opts.MultiPods = true
-	}
-	pr := NewPod(b.Connection)
-	for _, p := range pods {
-		po := p.(*v1.Pod)
-		if po.Status.Phase == v1.PodRunning {
-			opts.Namespace, opts.Name = po.Namespace, po.Name
-			if err := pr.PodLogs(ctx, c, opts); err != nil {
-				return err
-			}
-		}
-	}
+	// Pods may come and go during the session so always tag lines with their pod.
+	opts.MultiPods = true
+	w := newPodLogsWatcher(i, lsel, NewPod(b.Connection).Logs, c, opts)
+	go w.run(ctx)
+
 	return nil
 }
@@ -9,6 +9,10 @@ import (
 	batchv1beta1 "k8s.io/api/batch/v1beta1"
 )
 
+// Compile time checks to ensure type satisfies interface
+var _ ImageSetter = (*CronJob)(nil)
+var _ Suspendable = (*CronJob)(nil)
+
 type (
 	// CronJob tracks a kubernetes resource.
 	CronJob struct {
@@ -86,6 +90,31 @@ func (r *CronJob) Run(pa string) error {
 	return fmt.Errorf("unable to run cronjob %s", pa)
 }
 
+// Images returns the container images of the specified resource.
+func (r *CronJob) Images(ns, n string) ([]k8s.ContainerImage, error) {
+	return r.Resource.(ImageSetter).Images(ns, n)
+}
+
+// SetImages updates the container images of the specified resource.
+func (r *CronJob) SetImages(ns, n string, ii []k8s.ContainerImage) error {
+	return r.Resource.(ImageSetter).SetImages(ns, n, ii)
+}
+
+// IsSuspended checks if the specified resource is suspended.
+func (r *CronJob) IsSuspended(ns, n string) (bool, error) {
+	return r.Resource.(Suspendable).IsSuspended(ns, n)
+}
+
+// Suspend the specified resource.
+func (r *CronJob) Suspend(ns, n string) error {
+	return r.Resource.(Suspendable).Suspend(ns, n)
+}
+
+// Resume the specified resource.
+func (r *CronJob) Resume(ns, n string) error {
+	return r.Resource.(Suspendable).Resume(ns, n)
+}
+
 // Header return resource header.
 func (*CronJob) Header(ns string) Row {
 	hh := Row{}
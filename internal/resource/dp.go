@@ -12,7 +12,11 @@ import (
 
 // Compile time checks to ensure type satisfies interface
 var _ Restartable = (*Deployment)(nil)
+var _ Rollbackable = (*Deployment)(nil)
 var _ Scalable = (*Deployment)(nil)
+var _ Pausable = (*Deployment)(nil)
+var _ ImageSetter = (*Deployment)(nil)
+var _ Trackable = (*Deployment)(nil)
 
 // Deployment tracks a kubernetes resource.
 type Deployment struct {
@@ -138,3 +142,43 @@ func (r *Deployment) Scale(ns, n string, replicas int32) error {
 func (r *Deployment) Restart(ns, n string) error {
 	return r.Resource.(Restartable).Restart(ns, n)
 }
+
+// History returns the rollout history of the specified resource.
+func (r *Deployment) History(ns, n string) ([]k8s.Revision, error) {
+	return r.Resource.(Rollbackable).History(ns, n)
+}
+
+// Rollback the specified resource to a given revision.
+func (r *Deployment) Rollback(ns, n string, rev int64) error {
+	return r.Resource.(Rollbackable).Rollback(ns, n, rev)
+}
+
+// RolloutStatus returns the rollout progress of the listed resource.
+func (r *Deployment) RolloutStatus() k8s.RolloutStatus {
+	return k8s.DeploymentRolloutStatus(r.instance)
+}
+
+// Images returns the container images of the specified resource.
+func (r *Deployment) Images(ns, n string) ([]k8s.ContainerImage, error) {
+	return r.Resource.(ImageSetter).Images(ns, n)
+}
+
+// SetImages updates the container images of the specified resource.
+func (r *Deployment) SetImages(ns, n string, ii []k8s.ContainerImage) error {
+	return r.Resource.(ImageSetter).SetImages(ns, n, ii)
+}
+
+// IsPaused checks if the rollout of the specified resource is paused.
+func (r *Deployment) IsPaused(ns, n string) (bool, error) {
+	return r.Resource.(Pausable).IsPaused(ns, n)
+}
+
+// Pause the rollout of the specified resource.
+func (r *Deployment) Pause(ns, n string) error {
+	return r.Resource.(Pausable).Pause(ns, n)
+}
+
+// Resume the rollout of the specified resource.
+func (r *Deployment) Resume(ns, n string) error {
+	return r.Resource.(Pausable).Resume(ns, n)
+}
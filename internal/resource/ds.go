@@ -12,6 +12,9 @@ import (
 
 // Compile time checks to ensure type satisfies interface
 var _ Restartable = (*DaemonSet)(nil)
+var _ Rollbackable = (*DaemonSet)(nil)
+var _ Trackable = (*DaemonSet)(nil)
+var _ ImageSetter = (*DaemonSet)(nil)
 
 // DaemonSet tracks a kubernetes resource.
 type DaemonSet struct {
@@ -120,3 +123,28 @@ func (r *DaemonSet) Fields(ns string) Row {
 func (r *DaemonSet) Restart(ns, n string) error {
 	return r.Resource.(Restartable).Restart(ns, n)
 }
+
+// History returns the rollout history of the specified resource.
+func (r *DaemonSet) History(ns, n string) ([]k8s.Revision, error) {
+	return r.Resource.(Rollbackable).History(ns, n)
+}
+
+// Rollback the specified resource to a given revision.
+func (r *DaemonSet) Rollback(ns, n string, rev int64) error {
+	return r.Resource.(Rollbackable).Rollback(ns, n, rev)
+}
+
+// RolloutStatus returns the rollout progress of the listed resource.
+func (r *DaemonSet) RolloutStatus() k8s.RolloutStatus {
+	return k8s.DaemonSetRolloutStatus(r.instance)
+}
+
+// Images returns the container images of the specified resource.
+func (r *DaemonSet) Images(ns, n string) ([]k8s.ContainerImage, error) {
+	return r.Resource.(ImageSetter).Images(ns, n)
+}
+
+// SetImages updates the container images of the specified resource.
+func (r *DaemonSet) SetImages(ns, n string, ii []k8s.ContainerImage) error {
+	return r.Resource.(ImageSetter).SetImages(ns, n, ii)
+}
@@ -2,6 +2,7 @@ package resource
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strconv"
 	"strings"
@@ -12,6 +13,8 @@ import (
 	"github.com/rs/zerolog/log"
 	batchv1 "k8s.io/api/batch/v1"
 	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/duration"
 )
 
@@ -20,6 +23,7 @@ type Job struct {
 	*Base
 
 	instance *batchv1.Job
+	owner    types.UID
 	mx       sync.RWMutex
 }
 
@@ -33,6 +37,19 @@ func NewJobList(c Connection, ns string) List {
 	)
 }
 
+// NewOwnedJobList returns a resource list of jobs controlled by a given owner.
+func NewOwnedJobList(c Connection, ns string, owner types.UID) List {
+	j := NewJob(c)
+	j.owner = owner
+
+	return NewList(
+		ns,
+		"job",
+		j,
+		AllVerbsAccess|DescribeAccess,
+	)
+}
+
 // NewJob instantiates a new Job.
 func NewJob(c Connection) *Job {
 	j := &Job{
@@ -46,6 +63,7 @@ func NewJob(c Connection) *Job {
 // New builds a new Job instance from a k8s resource.
 func (r *Job) New(i interface{}) Columnar {
 	c := NewJob(r.Connection)
+	c.owner = r.owner
 	switch instance := i.(type) {
 	case *batchv1.Job:
 		c.instance = instance
@@ -95,12 +113,37 @@ func (r *Job) Logs(ctx context.Context, c chan<- string, opts LogOptions) error
 	return r.podLogs(ctx, c, jo.Spec.Selector.MatchLabels, opts)
 }
 
+// List resources for a given namespace.
+func (r *Job) List(ns string, opts metav1.ListOptions) (Columnars, error) {
+	ii, err := r.Resource.List(ns, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	cc := make(Columnars, 0, len(ii))
+	for _, i := range ii {
+		jo, ok := i.(batchv1.Job)
+		if !ok {
+			return nil, errors.New("expecting a job resource")
+		}
+		if r.owner != "" && !isControlledBy(jo.ObjectMeta, r.owner) {
+			continue
+		}
+		cc = append(cc, r.New(&jo))
+	}
+
+	return cc, nil
+}
+
 // Header return resource header.
-func (*Job) Header(ns string) Row {
+func (r *Job) Header(ns string) Row {
 	hh := Row{}
 	if ns == AllNamespaces {
 		hh = append(hh, "NAMESPACE")
 	}
+	if r.owner != "" {
+		return append(hh, "NAME", "STATUS", "COMPLETIONS", "DURATION", "SCHEDULED", "AGE")
+	}
 
 	return append(hh, "NAME", "COMPLETIONS", "DURATION", "CONTAINERS", "IMAGES", "AGE")
 }
@@ -114,6 +157,17 @@ func (r *Job) Fields(ns string) Row {
 		ff = append(ff, i.Namespace)
 	}
 
+	if r.owner != "" {
+		return append(ff,
+			i.Name,
+			r.toStatus(i.Status),
+			r.toCompletion(i.Spec, i.Status),
+			r.toDuration(i.Status),
+			r.toScheduled(i),
+			toAge(i.ObjectMeta.CreationTimestamp),
+		)
+	}
+
 	cc, ii := r.toContainers(i.Spec.Template.Spec)
 
 	return append(ff,
@@ -189,3 +243,37 @@ func (*Job) toDuration(status batchv1.JobStatus) string {
 
 	return duration.HumanDuration(d)
 }
+
+func (*Job) toStatus(status batchv1.JobStatus) string {
+	for _, c := range status.Conditions {
+		if c.Status != v1.ConditionTrue {
+			continue
+		}
+		switch c.Type {
+		case batchv1.JobComplete:
+			return "Complete"
+		case batchv1.JobFailed:
+			return "Failed"
+		}
+	}
+	if status.Active > 0 {
+		return "Running"
+	}
+
+	return "Pending"
+}
+
+func (*Job) toScheduled(jo *batchv1.Job) string {
+	t, ok := k8s.JobScheduledTime(jo)
+	if !ok {
+		return MissingValue
+	}
+
+	return toAgeHuman(toAge(metav1.Time{Time: t}))
+}
+
+func isControlledBy(m metav1.ObjectMeta, uid types.UID) bool {
+	ref := metav1.GetControllerOf(&m)
+
+	return ref != nil && ref.UID == uid
+}
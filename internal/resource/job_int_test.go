@@ -151,3 +151,56 @@ func TestJobToContainers(t *testing.T) {
 		assert.Equal(t, u.i, i)
 	}
 }
+
+func TestJobToStatus(t *testing.T) {
+	uu := map[string]struct {
+		s batchv1.JobStatus
+		e string
+	}{
+		"complete": {
+			s: batchv1.JobStatus{Conditions: []batchv1.JobCondition{{Type: batchv1.JobComplete, Status: v1.ConditionTrue}}},
+			e: "Complete",
+		},
+		"failed": {
+			s: batchv1.JobStatus{Conditions: []batchv1.JobCondition{{Type: batchv1.JobFailed, Status: v1.ConditionTrue}}},
+			e: "Failed",
+		},
+		"running": {
+			s: batchv1.JobStatus{Active: 1, Conditions: []batchv1.JobCondition{{Type: batchv1.JobFailed, Status: v1.ConditionFalse}}},
+			e: "Running",
+		},
+		"pending": {
+			e: "Pending",
+		},
+	}
+
+	var j *Job
+	for k, u := range uu {
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, j.toStatus(u.s))
+		})
+	}
+}
+
+func TestOwnedJobFields(t *testing.T) {
+	ctrl := true
+	jo := batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:         "blee",
+			Name:              "fred-26175330",
+			CreationTimestamp: metav1.Time{Time: testTime()},
+			OwnerReferences:   []metav1.OwnerReference{{Kind: "CronJob", Name: "fred", UID: "cj1", Controller: &ctrl}},
+		},
+		Status: batchv1.JobStatus{Active: 1},
+	}
+
+	j := NewJob(nil)
+	j.owner = "cj1"
+	assert.Equal(t, Row{"NAME", "STATUS", "COMPLETIONS", "DURATION", "SCHEDULED", "AGE"}, j.Header("blee"))
+	ff := j.New(jo).Fields("blee")
+	assert.Equal(t, Row{"fred-26175330", "Running", "0/1", MissingValue}, ff[:4])
+	assert.NotEqual(t, MissingValue, ff[4])
+
+	assert.True(t, isControlledBy(jo.ObjectMeta, "cj1"))
+	assert.False(t, isControlledBy(jo.ObjectMeta, "cj2"))
+}
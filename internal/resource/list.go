@@ -77,6 +77,7 @@ type (
 		GetNamespace() string
 		SetNamespace(string)
 		Reconcile(informer *wa.Informer, path *string) error
+		Item(name string) (Columnar, bool)
 		GetName() string
 		Access(flag int) bool
 		GetAccess() int
@@ -122,6 +123,7 @@ type (
 		verbs           int
 		resource        Resource
 		cache           RowEvents
+		items           map[string]Columnar
 		fieldSelector   string
 		labelSelector   string
 	}
@@ -203,7 +205,7 @@ func (l *list) SetNamespace(n string) {
 	if l.namespace == n {
 		return
 	}
-	l.cache = RowEvents{}
+	l.cache, l.items = RowEvents{}, nil
 	if l.Access(NamespaceAccess) {
 		l.namespace = n
 		if n == AllNamespace {
@@ -222,6 +224,13 @@ func (l *list) Resource() Resource {
 	return l.resource
 }
 
+// Item returns the resource with the given name as of the last reconciliation.
+func (l *list) Item(n string) (Columnar, bool) {
+	i, ok := l.items[n]
+
+	return i, ok
+}
+
 // Cache tracks previous resource state.
 func (l *list) Data() TableData {
 	return TableData{
@@ -311,8 +320,10 @@ func (l *list) Reconcile(informer *wa.Informer, path *string) error {
 func (l *list) update(items Columnars) {
 	first := len(l.cache) == 0
 	kk := make([]string, 0, len(items))
+	l.items = make(map[string]Columnar, len(items))
 	for _, i := range items {
 		kk = append(kk, i.Name())
+		l.items[i.Name()] = i
 		ff := i.Fields(l.namespace)
 		if first {
 			l.cache[i.Name()] = newRowEvent(New, ff, make(Row, len(ff)))
@@ -1,11 +1,25 @@
 package resource
 
 import (
+	"fmt"
 	"strings"
+	"time"
 
 	"github.com/derailed/k9s/internal/color"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
+// LogTimeFormats tracks the supported log window timestamp formats.
+var LogTimeFormats = []string{
+	time.RFC3339Nano,
+	time.RFC3339,
+	"2006-01-02T15:04Z07:00",
+	"2006-01-02T15:04",
+	"2006-01-02 15:04:05",
+	"2006-01-02 15:04",
+}
+
 type (
 	// Fqn uniquely describes a container
 	Fqn struct {
@@ -18,6 +32,10 @@ type (
 
 		Lines           int64
 		Previous        bool
+		Timestamps      bool
+		SinceSeconds    int64
+		SinceTime       *metav1.Time
+		UntilTime       *metav1.Time
 		Color           color.Paint
 		SingleContainer bool
 		MultiPods       bool
@@ -39,6 +57,93 @@ func (o LogOptions) Path() string {
 	return o.FQN() + ":" + o.Container
 }
 
+// HasWindow checks if logs are constrained by a time window.
+func (o LogOptions) HasWindow() bool {
+	return o.SinceSeconds > 0 || o.SinceTime != nil || o.UntilTime != nil
+}
+
+// SetWindow constrains the logs to a given time window. Since is either a relative
+// duration (ie 10m) or a timestamp. Until is optional and must be a timestamp.
+func (o *LogOptions) SetWindow(since, until string) error {
+	o.SinceSeconds, o.SinceTime, o.UntilTime = 0, nil, nil
+
+	if since = strings.TrimSpace(since); since != "" {
+		if d, err := time.ParseDuration(since); err == nil {
+			if d <= 0 {
+				return fmt.Errorf("Invalid log window duration %q", since)
+			}
+			o.SinceSeconds = int64(d.Seconds())
+		} else {
+			t, err := ParseLogTime(since)
+			if err != nil {
+				return err
+			}
+			o.SinceTime = t
+		}
+	}
+
+	if until = strings.TrimSpace(until); until != "" {
+		t, err := ParseLogTime(until)
+		if err != nil {
+			return err
+		}
+		if o.SinceTime != nil && !t.After(o.SinceTime.Time) {
+			return fmt.Errorf("Log window end %q must be after its start %q", until, since)
+		}
+		o.UntilTime = t
+	}
+
+	return nil
+}
+
+// ToPodLogOptions returns the api-server log request options.
+func (o LogOptions) ToPodLogOptions() *v1.PodLogOptions {
+	opts := v1.PodLogOptions{
+		Container: o.Container,
+		Follow:    true,
+		Previous:  o.Previous,
+	}
+
+	switch {
+	case o.SinceSeconds > 0:
+		secs := o.SinceSeconds
+		opts.SinceSeconds = &secs
+	case o.SinceTime != nil:
+		opts.SinceTime = o.SinceTime
+	case o.UntilTime == nil:
+		lines := o.Lines
+		opts.TailLines = &lines
+	}
+
+	// Timestamps are used to close log windows on the client side and as a cursor
+	// to resume streams.
+	opts.Timestamps = true
+	opts.Follow = o.Follows()
+
+	return &opts
+}
+
+// Follows checks if the logs should be streamed as they come in.
+func (o LogOptions) Follows() bool {
+	if o.Previous {
+		return false
+	}
+
+	return o.UntilTime == nil || o.UntilTime.After(time.Now())
+}
+
+// ParseLogTime converts a user supplied timestamp to a time.
+func ParseLogTime(s string) (*metav1.Time, error) {
+	for _, f := range LogTimeFormats {
+		if t, err := time.ParseInLocation(f, s, time.Local); err == nil {
+			mt := metav1.NewTime(t)
+			return &mt, nil
+		}
+	}
+
+	return nil, fmt.Errorf("Invalid log timestamp %q. Expecting a duration or a time ie 2019-10-01T10:00Z", s)
+}
+
 // FixedSizeName returns a normalize fixed size pod name if possible.
 func (o LogOptions) FixedSizeName() string {
 	tokens := strings.Split(o.Name, "-")
@@ -0,0 +1,99 @@
+package resource
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestLogOptionsSetWindow(t *testing.T) {
+	uu := map[string]struct {
+		since, until string
+		secs         int64
+		hasSince     bool
+		hasUntil     bool
+		err          bool
+	}{
+		"none":      {},
+		"duration":  {since: "10m", secs: 600},
+		"time":      {since: "2019-10-01T10:00Z", hasSince: true},
+		"range":     {since: "2019-10-01T10:00Z", until: "2019-10-01T11:00:00Z", hasSince: true, hasUntil: true},
+		"rangeDur":  {since: "1h", until: "2019-10-01T11:00:00Z", secs: 3600, hasUntil: true},
+		"badSince":  {since: "fred", err: true},
+		"badUntil":  {since: "10m", until: "10m", err: true},
+		"negative":  {since: "-10m", err: true},
+		"backwards": {since: "2019-10-01T11:00Z", until: "2019-10-01T10:00Z", err: true},
+	}
+
+	for k, u := range uu {
+		t.Run(k, func(t *testing.T) {
+			var o LogOptions
+			err := o.SetWindow(u.since, u.until)
+			if u.err {
+				assert.NotNil(t, err)
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, u.secs, o.SinceSeconds)
+			assert.Equal(t, u.hasSince, o.SinceTime != nil)
+			assert.Equal(t, u.hasUntil, o.UntilTime != nil)
+		})
+	}
+}
+
+func TestLogOptionsToPodLogOptions(t *testing.T) {
+	o := LogOptions{Fqn: Fqn{Container: "c1"}, Lines: 100}
+	po := o.ToPodLogOptions()
+	assert.Equal(t, "c1", po.Container)
+	assert.Equal(t, int64(100), *po.TailLines)
+	assert.True(t, po.Follow)
+	assert.Nil(t, po.SinceSeconds)
+
+	assert.Nil(t, o.SetWindow("5m", ""))
+	po = o.ToPodLogOptions()
+	assert.Nil(t, po.TailLines)
+	assert.Equal(t, int64(300), *po.SinceSeconds)
+	assert.True(t, po.Follow)
+
+	assert.Nil(t, o.SetWindow("2019-10-01T10:00Z", "2019-10-01T11:00Z"))
+	po = o.ToPodLogOptions()
+	assert.Nil(t, po.TailLines)
+	assert.NotNil(t, po.SinceTime)
+	assert.True(t, po.Timestamps)
+	assert.False(t, po.Follow)
+}
+
+func TestLogOptionsFollows(t *testing.T) {
+	past, future := metav1.NewTime(time.Now().Add(-time.Hour)), metav1.NewTime(time.Now().Add(time.Hour))
+
+	assert.True(t, LogOptions{}.Follows())
+	assert.False(t, LogOptions{Previous: true}.Follows())
+	assert.False(t, LogOptions{UntilTime: &past}.Follows())
+	assert.True(t, LogOptions{UntilTime: &future}.Follows())
+}
+
+func TestSplitLogTimestamp(t *testing.T) {
+	uu := map[string]struct {
+		line, msg string
+		ok        bool
+	}{
+		"plain":  {"hello world", "hello world", false},
+		"stamp":  {"2019-10-01T10:00:00.123456789Z hello world", "hello world", true},
+		"blank":  {"2019-10-01T10:00:00Z", "", true},
+		"broken": {"2019-10-01 hello", "2019-10-01 hello", false},
+	}
+
+	for k, u := range uu {
+		t.Run(k, func(t *testing.T) {
+			ts, msg, ok := splitLogTimestamp(u.line)
+			assert.Equal(t, u.ok, ok)
+			assert.Equal(t, u.msg, msg)
+			if ok {
+				assert.Equal(t, 2019, ts.Year())
+				assert.Equal(t, time.October, ts.Month())
+			}
+		})
+	}
+}
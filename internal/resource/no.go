@@ -17,6 +17,9 @@ const (
 	nodeLabelRole       = "kubernetes.io/role"
 )
 
+// Compile time checks to ensure type satisfies interface
+var _ Drainable = (*Node)(nil)
+
 // Node tracks a kubernetes resource.
 type Node struct {
 	*Base
@@ -63,6 +66,16 @@ func (r *Node) New(i interface{}) Columnar {
 	return c
 }
 
+// Cordon marks a node as (un)schedulable.
+func (r *Node) Cordon(name string, cordon bool) error {
+	return r.Resource.(Drainable).Cordon(name, cordon)
+}
+
+// Drain evicts all pods from a node.
+func (r *Node) Drain(name string, opts k8s.DrainOptions, fn k8s.DrainFn) error {
+	return r.Resource.(Drainable).Drain(name, opts, fn)
+}
+
 // SetNodeMetrics set the current k8s resource metrics on a given node.
 func (r *Node) SetNodeMetrics(m *mv1beta1.NodeMetrics) {
 	r.metrics = m
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"io"
 	"strconv"
+	"strings"
 	"sync/atomic"
 	"time"
 
@@ -20,9 +21,15 @@ import (
 )
 
 const (
-	defaultTimeout = 1 * time.Second
+	defaultTimeout   = 1 * time.Second
+	logRetryDelay    = 1 * time.Second
+	maxLogRetryDelay = 10 * time.Second
+	logTimestampFmt  = "2006-01-02T15:04:05.000000000Z07:00"
 )
 
+// Compile time checks to ensure type satisfies interface
+var _ ImageSetter = (*Pod)(nil)
+
 type (
 	// IKey informer context key.
 	IKey string
@@ -117,6 +124,16 @@ func (r *Pod) Containers(path string, includeInit bool) ([]string, error) {
 	return r.Resource.(k8s.Loggable).Containers(ns, po, includeInit)
 }
 
+// Images returns the container images of the specified resource.
+func (r *Pod) Images(ns, n string) ([]k8s.ContainerImage, error) {
+	return r.Resource.(ImageSetter).Images(ns, n)
+}
+
+// SetImages updates the container images of the specified resource.
+func (r *Pod) SetImages(ns, n string, ii []k8s.ContainerImage) error {
+	return r.Resource.(ImageSetter).SetImages(ns, n, ii)
+}
+
 // PodLogs tail logs for all containers in a running Pod.
 func (r *Pod) PodLogs(ctx context.Context, c chan<- string, opts LogOptions) error {
 	i := ctx.Value(IKey("informer")).(*watch.Informer)
@@ -166,13 +183,18 @@ func (r *Pod) Logs(ctx context.Context, c chan<- string, opts LogOptions) error
 
 func tailLogs(ctx context.Context, res k8s.Loggable, c chan<- string, opts LogOptions) error {
 	log.Debug().Msgf("Tailing logs for %q/%q:%q", opts.Namespace, opts.Name, opts.Container)
-	o := v1.PodLogOptions{
-		Container: opts.Container,
-		Follow:    true,
-		TailLines: &opts.Lines,
-		Previous:  opts.Previous,
+	stream, err := openLogStream(ctx, res, opts)
+	if err != nil {
+		log.Error().Err(err).Msgf("Log stream failed for `%s", opts.Path())
+		return fmt.Errorf("Unable to obtain log stream for %s", opts.Path())
 	}
-	req := res.Logs(opts.Namespace, opts.Name, &o)
+	go streamLogs(ctx, res, stream, c, opts)
+
+	return nil
+}
+
+func openLogStream(ctx context.Context, res k8s.Loggable, opts LogOptions) (io.ReadCloser, error) {
+	req := res.Logs(opts.Namespace, opts.Name, opts.ToPodLogOptions())
 	ctxt, cancelFunc := context.WithCancel(ctx)
 	req.Context(ctxt)
 
@@ -182,13 +204,8 @@ func tailLogs(ctx context.Context, res k8s.Loggable, c chan<- string, opts LogOp
 	// This call will block if nothing is in the stream!!
 	stream, err := req.Stream()
 	atomic.StoreInt32(&blocked, 0)
-	if err != nil {
-		log.Error().Err(err).Msgf("Log stream failed for `%s", opts.Path())
-		return fmt.Errorf("Unable to obtain log stream for %s", opts.Path())
-	}
-	go readLogs(ctx, stream, c, opts)
 
-	return nil
+	return stream, err
 }
 
 func logsTimeout(cancel context.CancelFunc, blocked *int32) {
@@ -201,21 +218,150 @@ func logsTimeout(cancel context.CancelFunc, blocked *int32) {
 	}
 }
 
-func readLogs(ctx context.Context, stream io.ReadCloser, c chan<- string, opts LogOptions) {
+// StreamLogs pumps a container logs. When following, closed streams are re-opened
+// from the last seen log timestamp so that container restarts or api-server hiccups
+// do not end the session.
+func streamLogs(ctx context.Context, res k8s.Loggable, stream io.ReadCloser, c chan<- string, opts LogOptions) {
+	restarts, _ := containerRestarts(ctx, opts)
+	var last time.Time
+	for {
+		var done bool
+		if last, done = readLogs(ctx, stream, c, opts, last); done || !opts.Follows() {
+			return
+		}
+
+		log.Debug().Msgf("Log stream closed for `%s. Reconnecting...", opts.Path())
+		if stream = reopenLogStream(ctx, res, opts, last); stream == nil {
+			return
+		}
+		count, ok := containerRestarts(ctx, opts)
+		if !ok || count <= restarts {
+			continue
+		}
+		restarts = count
+		msg := fmt.Sprintf("--- container restarted (restart #%d) ---", count)
+		if opts.Color != 0 {
+			msg = colorize(opts.Color, msg)
+		}
+		select {
+		case <-ctx.Done():
+			stream.Close()
+			return
+		case c <- opts.DecorateLog(msg):
+		}
+	}
+}
+
+// ReopenLogStream retries to open a log stream from a given cursor until the
+// context is canceled or the pod goes away.
+func reopenLogStream(ctx context.Context, res k8s.Loggable, opts LogOptions, cursor time.Time) io.ReadCloser {
+	if !cursor.IsZero() {
+		t := metav1.NewTime(cursor)
+		opts.SinceSeconds, opts.SinceTime = 0, &t
+	}
+
+	for delay := logRetryDelay; ; delay *= 2 {
+		if delay > maxLogRetryDelay {
+			delay = maxLogRetryDelay
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(delay):
+		}
+		if _, ok := containerRestarts(ctx, opts); !ok {
+			log.Debug().Msgf("Container `%s is gone. Bailing out!", opts.Path())
+			return nil
+		}
+		stream, err := openLogStream(ctx, res, opts)
+		if err == nil {
+			return stream
+		}
+		log.Debug().Err(err).Msgf("Reconnecting log stream `%s", opts.Path())
+	}
+}
+
+// ContainerRestarts returns a container restart count and whether the container still exists.
+func containerRestarts(ctx context.Context, opts LogOptions) (int32, bool) {
+	i, ok := ctx.Value(IKey("informer")).(*watch.Informer)
+	if !ok || i == nil {
+		return 0, true
+	}
+	o, err := i.Get(watch.PodIndex, opts.FQN(), metav1.GetOptions{})
+	if err != nil {
+		return 0, false
+	}
+
+	po := o.(*v1.Pod)
+	for _, ss := range [][]v1.ContainerStatus{po.Status.InitContainerStatuses, po.Status.ContainerStatuses} {
+		for _, s := range ss {
+			if s.Name == opts.Container {
+				return s.RestartCount, true
+			}
+		}
+	}
+
+	return 0, false
+}
+
+// ReadLogs pumps log lines until the stream closes. Lines logged prior to the
+// given cursor are skipped. It returns the last line timestamp and whether
+// the stream should no longer be followed.
+func readLogs(ctx context.Context, stream io.ReadCloser, c chan<- string, opts LogOptions, cursor time.Time) (time.Time, bool) {
 	defer func() {
 		log.Debug().Msgf(">>> Closing stream `%s", opts.Path())
 		stream.Close()
 	}()
 
+	last := cursor
 	scanner := bufio.NewScanner(stream)
 	for scanner.Scan() {
+		t, line, ok := splitLogTimestamp(scanner.Text())
+		if ok {
+			if !cursor.IsZero() && !t.After(cursor) {
+				continue
+			}
+			if opts.UntilTime != nil && t.After(opts.UntilTime.Time) {
+				log.Debug().Msgf("Reached end of log window for `%s", opts.Path())
+				return last, true
+			}
+			last = t
+		}
+		// Timestamps go after the pod/container header so views can still split it off.
+		if ok && opts.Timestamps {
+			line = t.Format(logTimestampFmt) + " " + line
+		}
+		line = opts.DecorateLog(line)
 		select {
 		case <-ctx.Done():
-			return
-		default:
-			c <- opts.DecorateLog(scanner.Text())
+			return last, true
+		case c <- line:
 		}
 	}
+
+	select {
+	case <-ctx.Done():
+		return last, true
+	default:
+		return last, false
+	}
+}
+
+// splitLogTimestamp splits out the api-server timestamp from a log line.
+func splitLogTimestamp(line string) (time.Time, string, bool) {
+	i := strings.Index(line, " ")
+	if i == -1 {
+		i = len(line)
+	}
+	t, err := time.Parse(time.RFC3339Nano, line[:i])
+	if err != nil {
+		return t, line, false
+	}
+	if i == len(line) {
+		return t, "", true
+	}
+
+	return t, line[i+1:], true
 }
 
 // List resources for a given namespace.
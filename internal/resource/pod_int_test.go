@@ -1,6 +1,9 @@
 package resource
 
 import (
+	"context"
+	"io/ioutil"
+	"strings"
 	"testing"
 	"time"
 
@@ -49,6 +52,58 @@ func TestPodStatuses(t *testing.T) {
 	}
 }
 
+func TestReadLogs(t *testing.T) {
+	lines := strings.Join([]string{
+		"2019-10-01T10:00:00.1Z line1",
+		"2019-10-01T10:00:00.2Z line2",
+		"2019-10-01T10:00:00.3Z line3",
+	}, "\n")
+	t2, _ := time.Parse(time.RFC3339Nano, "2019-10-01T10:00:00.2Z")
+	t3, _ := time.Parse(time.RFC3339Nano, "2019-10-01T10:00:00.3Z")
+
+	uu := map[string]struct {
+		cursor time.Time
+		until  *metav1.Time
+		stamps bool
+		multi  bool
+		e      []string
+		last   time.Time
+		done   bool
+	}{
+		"all":    {e: []string{"line1", "line2", "line3"}, last: t3},
+		"cursor": {cursor: t2, e: []string{"line3"}, last: t3},
+		"until":  {until: &metav1.Time{Time: t2}, e: []string{"line1", "line2"}, last: t2, done: true},
+		"stamps": {cursor: t2, stamps: true, e: []string{"2019-10-01T10:00:00.300000000Z line3"}, last: t3},
+		"header": {cursor: t2, stamps: true, multi: true, e: []string{colorize(32, "p1:c1 ") + "2019-10-01T10:00:00.300000000Z line3"}, last: t3},
+	}
+
+	for k, u := range uu {
+		t.Run(k, func(t *testing.T) {
+			c := make(chan string, 10)
+			opts := LogOptions{SingleContainer: true, UntilTime: u.until, Timestamps: u.stamps}
+			if u.multi {
+				opts.Fqn, opts.Color, opts.MultiPods = Fqn{Name: "p1", Container: "c1"}, 32, true
+			}
+			last, done := readLogs(context.Background(), ioutil.NopCloser(strings.NewReader(lines)), c, opts, u.cursor)
+			close(c)
+
+			var ll []string
+			for l := range c {
+				ll = append(ll, l)
+			}
+			assert.Equal(t, u.e, ll)
+			assert.Equal(t, u.last, last)
+			assert.Equal(t, u.done, done)
+		})
+	}
+}
+
+func TestContainerRestartsNoInformer(t *testing.T) {
+	count, ok := containerRestarts(context.Background(), LogOptions{})
+	assert.Equal(t, int32(0), count)
+	assert.True(t, ok)
+}
+
 func TestPodPhase(t *testing.T) {
 	uu := []struct {
 		p *v1.Pod
@@ -0,0 +1,201 @@
+package resource
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/derailed/k9s/internal/k8s"
+	"github.com/derailed/k9s/internal/watch"
+	"github.com/rs/zerolog/log"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const podLogsRefresh = 1 * time.Second
+
+type (
+	// PodLister represents a pod store.
+	podLister interface {
+		List(res, ns string, opts metav1.ListOptions) (k8s.Collection, error)
+	}
+
+	// TailFn tails logs for a given pod.
+	tailFn func(ctx context.Context, c chan<- string, opts LogOptions) error
+
+	// PodLogsWatcher aggregates logs for all pods matching a selector. Pods coming
+	// up are attached to as they become available and deleted pods are dropped.
+	// Each container is tailed on its own so a failing container never takes down
+	// its sibling streams.
+	podLogsWatcher struct {
+		lister  podLister
+		tail    tailFn
+		sel     string
+		opts    LogOptions
+		c       chan<- string
+		tails   map[string]map[string]context.CancelFunc
+		cursors map[string]time.Time
+		primed  bool
+		mx      sync.Mutex
+	}
+)
+
+func newPodLogsWatcher(l podLister, sel string, tail tailFn, c chan<- string, opts LogOptions) *podLogsWatcher {
+	return &podLogsWatcher{
+		lister:  l,
+		tail:    tail,
+		sel:     sel,
+		opts:    opts,
+		c:       c,
+		tails:   make(map[string]map[string]context.CancelFunc),
+		cursors: make(map[string]time.Time),
+	}
+}
+
+func (w *podLogsWatcher) run(ctx context.Context) {
+	defer log.Debug().Msgf("Pod logs watcher for %q canceled!", w.sel)
+
+	for {
+		w.reconcile(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(podLogsRefresh):
+		}
+	}
+}
+
+func (w *podLogsWatcher) reconcile(ctx context.Context) {
+	pods, err := w.lister.List(watch.PodIndex, w.opts.Namespace, metav1.ListOptions{LabelSelector: w.sel})
+	if err != nil {
+		log.Error().Err(err).Msgf("Listing pods for %q", w.sel)
+		return
+	}
+
+	w.mx.Lock()
+	defer w.mx.Unlock()
+	seen := make(map[string]bool, len(pods))
+	for _, p := range pods {
+		po := p.(*v1.Pod)
+		fqn := FQN(po.Namespace, po.Name)
+		seen[fqn] = true
+		if !isLoggable(po) {
+			continue
+		}
+		tails, ok := w.tails[fqn]
+		if !ok {
+			if w.primed {
+				w.notify(ctx, po.Name, "started")
+			}
+			tails = make(map[string]context.CancelFunc)
+			w.tails[fqn] = tails
+		}
+		cos := logContainers(po)
+		for _, co := range cos {
+			if _, ok := tails[co]; !ok {
+				w.attach(ctx, po, co, len(cos) == 1)
+			}
+		}
+	}
+
+	for fqn, tails := range w.tails {
+		if seen[fqn] {
+			continue
+		}
+		for co, cancel := range tails {
+			cancel()
+			delete(w.cursors, fqn+":"+co)
+		}
+		delete(w.tails, fqn)
+		_, n := Namespaced(fqn)
+		w.notify(ctx, n, "deleted")
+	}
+	w.primed = true
+}
+
+func (w *podLogsWatcher) attach(ctx context.Context, po *v1.Pod, co string, single bool) {
+	opts := w.opts
+	opts.Namespace, opts.Name, opts.Container = po.Namespace, po.Name, co
+	opts.Color, opts.SingleContainer = asColor(po.Name), single
+	// Retries pick up where the failed tail left off rather than replaying the tail window.
+	if t, ok := w.cursors[opts.Path()]; ok {
+		cursor := metav1.NewTime(t)
+		opts.SinceSeconds, opts.SinceTime = 0, &cursor
+	}
+
+	var cctx context.Context
+	cctx, w.tails[opts.FQN()][co] = context.WithCancel(ctx)
+	started := time.Now()
+	go func() {
+		if err := w.tail(cctx, w.c, opts); err != nil {
+			log.Error().Err(err).Msgf("Tailing logs for container %s failed", opts.Path())
+			w.detach(cctx, opts, started)
+			return
+		}
+		w.mx.Lock()
+		delete(w.cursors, opts.Path())
+		w.mx.Unlock()
+	}()
+}
+
+// Detach drops a failed container tail so it gets retried on the next reconciliation
+// from the time the failed attempt started.
+func (w *podLogsWatcher) detach(ctx context.Context, opts LogOptions, started time.Time) {
+	w.mx.Lock()
+	defer w.mx.Unlock()
+
+	// A canceled tail was already dropped by the reconciler.
+	if ctx.Err() != nil {
+		return
+	}
+	tails, ok := w.tails[opts.FQN()]
+	if !ok {
+		return
+	}
+	if cancel, ok := tails[opts.Container]; ok {
+		cancel()
+		delete(tails, opts.Container)
+	}
+	if _, ok := w.cursors[opts.Path()]; !ok {
+		w.cursors[opts.Path()] = started
+	}
+}
+
+func (w *podLogsWatcher) notify(ctx context.Context, po, action string) {
+	msg := colorize(asColor(po), fmt.Sprintf("--- pod %s %s ---", po, action))
+	if msg == "" {
+		return
+	}
+	select {
+	case <-ctx.Done():
+	case w.c <- msg:
+	}
+}
+
+func isLoggable(po *v1.Pod) bool {
+	switch po.Status.Phase {
+	case v1.PodRunning, v1.PodSucceeded, v1.PodFailed:
+		return true
+	default:
+		return false
+	}
+}
+
+// LogContainers returns the names of all the pod containers that have logs.
+func logContainers(po *v1.Pod) []string {
+	cos := make([]string, 0, len(po.Spec.InitContainers)+len(po.Spec.Containers))
+	for _, co := range po.Spec.InitContainers {
+		cos = append(cos, co.Name)
+	}
+	for _, co := range po.Spec.Containers {
+		for _, s := range po.Status.ContainerStatuses {
+			if s.Name == co.Name {
+				cos = append(cos, co.Name)
+				break
+			}
+		}
+	}
+
+	return cos
+}
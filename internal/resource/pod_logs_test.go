@@ -0,0 +1,229 @@
+package resource
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/k8s"
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+type fakePodLister struct {
+	pods k8s.Collection
+}
+
+func (f *fakePodLister) List(_, _ string, _ metav1.ListOptions) (k8s.Collection, error) {
+	return f.pods, nil
+}
+
+type fakeTailer struct {
+	mx     sync.Mutex
+	wg     sync.WaitGroup
+	tailed map[string]context.Context
+	err    error
+}
+
+func (f *fakeTailer) tail(ctx context.Context, c chan<- string, opts LogOptions) error {
+	defer f.wg.Done()
+	f.mx.Lock()
+	defer f.mx.Unlock()
+	f.tailed[opts.Name] = ctx
+
+	return f.err
+}
+
+// LogTailer emits a pod container logs past its cursor or fails for broken containers.
+type logTailer struct {
+	mx     sync.Mutex
+	wg     sync.WaitGroup
+	lines  map[string][]time.Time
+	broken map[string]bool
+	ctxs   map[string]context.Context
+}
+
+func (f *logTailer) tail(ctx context.Context, c chan<- string, opts LogOptions) error {
+	defer f.wg.Done()
+	f.mx.Lock()
+	defer f.mx.Unlock()
+	f.ctxs[opts.Container] = ctx
+	if f.broken[opts.Container] {
+		return errors.New("boom")
+	}
+	for _, t := range f.lines[opts.Container] {
+		if opts.SinceTime != nil && t.Before(opts.SinceTime.Time) {
+			continue
+		}
+		c <- opts.Container + " " + t.Format(time.RFC3339Nano)
+	}
+
+	return nil
+}
+
+func TestPodLogsWatcherReconcile(t *testing.T) {
+	l := fakePodLister{pods: k8s.Collection{
+		makeLogPod("p1", v1.PodRunning),
+		makeLogPod("p2", v1.PodPending),
+	}}
+	tailer := fakeTailer{tailed: map[string]context.Context{}}
+	c := make(chan string, 10)
+	w := newPodLogsWatcher(&l, "app=fred", tailer.tail, c, LogOptions{Fqn: Fqn{Namespace: "default"}})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	tailer.wg.Add(1)
+	w.reconcile(ctx)
+	tailer.wg.Wait()
+	assert.Equal(t, 1, len(w.tails))
+	assert.Equal(t, 0, len(c))
+
+	l.pods = k8s.Collection{
+		makeLogPod("p1", v1.PodRunning),
+		makeLogPod("p2", v1.PodRunning),
+	}
+	tailer.wg.Add(1)
+	w.reconcile(ctx)
+	tailer.wg.Wait()
+	assert.Equal(t, 2, len(w.tails))
+	assert.Equal(t, 1, len(c))
+
+	l.pods = k8s.Collection{makeLogPod("p2", v1.PodRunning)}
+	w.reconcile(ctx)
+	assert.Equal(t, 1, len(w.tails))
+	assert.Equal(t, 2, len(c))
+	assert.NotNil(t, tailer.tailed["p1"].Err())
+	assert.Nil(t, tailer.tailed["p2"].Err())
+}
+
+func TestPodLogsWatcherRetry(t *testing.T) {
+	l := fakePodLister{pods: k8s.Collection{makeLogPod("p1", v1.PodRunning)}}
+	tailer := fakeTailer{tailed: map[string]context.Context{}, err: errors.New("boom")}
+	c := make(chan string, 10)
+	w := newPodLogsWatcher(&l, "app=fred", tailer.tail, c, LogOptions{Fqn: Fqn{Namespace: "default"}})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	tailer.wg.Add(1)
+	w.reconcile(ctx)
+	tailer.wg.Wait()
+	for i := 0; i < 100 && tailCount(w) > 0; i++ {
+		time.Sleep(10 * time.Millisecond)
+	}
+	assert.Equal(t, 0, tailCount(w))
+	assert.NotNil(t, tailer.tailed["p1"].Err())
+
+	tailer.err = nil
+	tailer.wg.Add(1)
+	w.reconcile(ctx)
+	tailer.wg.Wait()
+	assert.Equal(t, 1, len(w.tails))
+	assert.Nil(t, tailer.tailed["p1"].Err())
+}
+
+func TestPodLogsWatcherContainerRetry(t *testing.T) {
+	l := fakePodLister{pods: k8s.Collection{makeLogPod("p1", v1.PodRunning, "c1", "c2")}}
+	now := time.Now()
+	tailer := logTailer{
+		lines: map[string][]time.Time{
+			"c1": {now.Add(-2 * time.Second), now.Add(-time.Second)},
+			"c2": {now.Add(-2 * time.Second)},
+		},
+		broken: map[string]bool{"c2": true},
+		ctxs:   map[string]context.Context{},
+	}
+	c := make(chan string, 10)
+	w := newPodLogsWatcher(&l, "app=fred", tailer.tail, c, LogOptions{Fqn: Fqn{Namespace: "default"}})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	tailer.wg.Add(2)
+	w.reconcile(ctx)
+	tailer.wg.Wait()
+	for i := 0; i < 100 && tailCount(w) > 1; i++ {
+		time.Sleep(10 * time.Millisecond)
+	}
+	assert.Equal(t, 1, tailCount(w))
+	assert.Nil(t, tailer.ctxs["c1"].Err())
+	assert.NotNil(t, tailer.ctxs["c2"].Err())
+
+	tailer.mx.Lock()
+	tailer.broken["c2"] = false
+	tailer.lines["c2"] = append(tailer.lines["c2"], time.Now().Add(time.Second))
+	tailer.mx.Unlock()
+	tailer.wg.Add(1)
+	w.reconcile(ctx)
+	tailer.wg.Wait()
+	w.reconcile(ctx)
+	assert.Equal(t, 2, tailCount(w))
+	assert.Nil(t, tailer.ctxs["c1"].Err())
+	assert.Nil(t, tailer.ctxs["c2"].Err())
+
+	close(c)
+	seen := make(map[string]int)
+	for l := range c {
+		seen[l]++
+	}
+	assert.Equal(t, 3, len(seen))
+	for l, n := range seen {
+		assert.Equal(t, 1, n, l)
+	}
+}
+
+func TestLogContainers(t *testing.T) {
+	po := makeLogPod("p1", v1.PodRunning, "c1", "c2")
+	po.Spec.InitContainers = []v1.Container{{Name: "i1"}}
+	po.Spec.Containers = append(po.Spec.Containers, v1.Container{Name: "c3"})
+
+	assert.Equal(t, []string{"i1", "c1", "c2"}, logContainers(po))
+}
+
+func TestIsLoggable(t *testing.T) {
+	uu := map[v1.PodPhase]bool{
+		v1.PodRunning:   true,
+		v1.PodSucceeded: true,
+		v1.PodFailed:    true,
+		v1.PodPending:   false,
+		v1.PodUnknown:   false,
+	}
+
+	for p, e := range uu {
+		assert.Equal(t, e, isLoggable(makeLogPod("p1", p)))
+	}
+}
+
+// Helpers...
+
+func tailCount(w *podLogsWatcher) int {
+	w.mx.Lock()
+	defer w.mx.Unlock()
+
+	var n int
+	for _, tails := range w.tails {
+		n += len(tails)
+	}
+
+	return n
+}
+
+func makeLogPod(n string, phase v1.PodPhase, cos ...string) *v1.Pod {
+	if len(cos) == 0 {
+		cos = []string{"c1"}
+	}
+	po := v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: n},
+		Status:     v1.PodStatus{Phase: phase},
+	}
+	for _, co := range cos {
+		po.Spec.Containers = append(po.Spec.Containers, v1.Container{Name: co})
+		po.Status.ContainerStatuses = append(po.Status.ContainerStatuses, v1.ContainerStatus{Name: co})
+	}
+
+	return &po
+}
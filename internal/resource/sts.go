@@ -12,6 +12,9 @@ import (
 
 // Compile time checks to ensure type satisfies interface
 var _ Restartable = (*StatefulSet)(nil)
+var _ Rollbackable = (*StatefulSet)(nil)
+var _ Trackable = (*StatefulSet)(nil)
+var _ ImageSetter = (*StatefulSet)(nil)
 var _ Scalable = (*StatefulSet)(nil)
 
 // StatefulSet tracks a kubernetes resource.
@@ -127,3 +130,28 @@ func (r *StatefulSet) Scale(ns, n string, replicas int32) error {
 func (r *StatefulSet) Restart(ns, n string) error {
 	return r.Resource.(Restartable).Restart(ns, n)
 }
+
+// History returns the rollout history of the specified resource.
+func (r *StatefulSet) History(ns, n string) ([]k8s.Revision, error) {
+	return r.Resource.(Rollbackable).History(ns, n)
+}
+
+// Rollback the specified resource to a given revision.
+func (r *StatefulSet) Rollback(ns, n string, rev int64) error {
+	return r.Resource.(Rollbackable).Rollback(ns, n, rev)
+}
+
+// RolloutStatus returns the rollout progress of the listed resource.
+func (r *StatefulSet) RolloutStatus() k8s.RolloutStatus {
+	return k8s.StatefulSetRolloutStatus(r.instance)
+}
+
+// Images returns the container images of the specified resource.
+func (r *StatefulSet) Images(ns, n string) ([]k8s.ContainerImage, error) {
+	return r.Resource.(ImageSetter).Images(ns, n)
+}
+
+// SetImages updates the container images of the specified resource.
+func (r *StatefulSet) SetImages(ns, n string, ii []k8s.ContainerImage) error {
+	return r.Resource.(ImageSetter).SetImages(ns, n, ii)
+}
@@ -0,0 +1,78 @@
+package dialog
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/derailed/k9s/internal/k8s"
+	"github.com/derailed/tview"
+	"github.com/gdamore/tcell"
+)
+
+const drainKey = "drain"
+
+// DefaultDrainOptions returns the initial drain dialog settings.
+func DefaultDrainOptions() k8s.DrainOptions {
+	return k8s.DrainOptions{
+		GracePeriodSeconds: -1,
+		Timeout:            5 * time.Minute,
+	}
+}
+
+// ShowDrain pops a node drain dialog.
+func ShowDrain(p *tview.Pages, msg string, opts k8s.DrainOptions, okFn func(k8s.DrainOptions), errFn func(error)) {
+	f := tview.NewForm()
+	f.SetItemPadding(0)
+	f.SetButtonsAlign(tview.AlignCenter).
+		SetButtonBackgroundColor(tview.Styles.PrimitiveBackgroundColor).
+		SetButtonTextColor(tview.Styles.PrimaryTextColor).
+		SetLabelColor(tcell.ColorAqua).
+		SetFieldTextColor(tcell.ColorOrange)
+
+	grace, timeout := strconv.Itoa(opts.GracePeriodSeconds), opts.Timeout.String()
+	f.AddInputField("Grace Period:", grace, 10, nil, func(s string) {
+		grace = s
+	})
+	f.AddInputField("Timeout:", timeout, 10, nil, func(s string) {
+		timeout = s
+	})
+	f.AddCheckbox("Ignore DaemonSets:", opts.IgnoreDaemonSets, func(b bool) {
+		opts.IgnoreDaemonSets = b
+	})
+	f.AddCheckbox("Delete EmptyDir Data:", opts.DeleteEmptyDirData, func(b bool) {
+		opts.DeleteEmptyDirData = b
+	})
+	f.AddCheckbox("Force:", opts.Force, func(b bool) {
+		opts.Force = b
+	})
+
+	f.AddButton("OK", func() {
+		var err error
+		if opts.GracePeriodSeconds, err = strconv.Atoi(grace); err != nil {
+			errFn(err)
+			return
+		}
+		if opts.Timeout, err = time.ParseDuration(timeout); err != nil {
+			errFn(err)
+			return
+		}
+		DismissDrain(p)
+		okFn(opts)
+	})
+	f.AddButton("Cancel", func() {
+		DismissDrain(p)
+	})
+
+	modal := tview.NewModalForm("<Drain>", f)
+	modal.SetText(msg)
+	modal.SetDoneFunc(func(_ int, b string) {
+		DismissDrain(p)
+	})
+	p.AddPage(drainKey, modal, false, false)
+	p.ShowPage(drainKey)
+}
+
+// DismissDrain dismiss the drain dialog.
+func DismissDrain(p *tview.Pages) {
+	p.RemovePage(drainKey)
+}
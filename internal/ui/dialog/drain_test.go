@@ -0,0 +1,23 @@
+package dialog
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/k8s"
+	"github.com/derailed/tview"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDrainDialog(t *testing.T) {
+	p := tview.NewPages()
+
+	okFunc := func(k8s.DrainOptions) {}
+	errFunc := func(error) {}
+	ShowDrain(p, "Drain node fred?", DefaultDrainOptions(), okFunc, errFunc)
+
+	d := p.GetPrimitive(drainKey).(*tview.ModalForm)
+	assert.NotNil(t, d)
+
+	DismissDrain(p)
+	assert.Nil(t, p.GetPrimitive(drainKey))
+}
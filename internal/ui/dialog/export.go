@@ -0,0 +1,65 @@
+package dialog
+
+import (
+	"github.com/derailed/tview"
+	"github.com/gdamore/tcell"
+)
+
+const exportKey = "export"
+
+// ExportFormats lists the supported table export formats.
+var ExportFormats = []string{"csv", "json", "yaml", "markdown"}
+
+// ExportOpts tracks table export options.
+type ExportOpts struct {
+	Format string
+	Marked bool
+	Full   bool
+}
+
+// ShowExport pops a table export dialog. The marked and full options are only
+// offered when available.
+func ShowExport(p *tview.Pages, marked, full bool, okFn func(ExportOpts)) {
+	f := tview.NewForm()
+	f.SetItemPadding(0)
+	f.SetButtonsAlign(tview.AlignCenter).
+		SetButtonBackgroundColor(tview.Styles.PrimitiveBackgroundColor).
+		SetButtonTextColor(tview.Styles.PrimaryTextColor).
+		SetLabelColor(tcell.ColorAqua).
+		SetFieldTextColor(tcell.ColorOrange)
+
+	opts := ExportOpts{Format: ExportFormats[0], Marked: marked}
+	f.AddDropDown("Format:", ExportFormats, 0, func(option string, _ int) {
+		opts.Format = option
+	})
+	if marked {
+		f.AddCheckbox("Marked Only:", opts.Marked, func(checked bool) {
+			opts.Marked = checked
+		})
+	}
+	if full {
+		f.AddCheckbox("Full Objects (json/yaml):", opts.Full, func(checked bool) {
+			opts.Full = checked
+		})
+	}
+
+	f.AddButton("OK", func() {
+		DismissExport(p)
+		okFn(opts)
+	})
+	f.AddButton("Cancel", func() {
+		DismissExport(p)
+	})
+
+	modal := tview.NewModalForm("<Export>", f)
+	modal.SetDoneFunc(func(int, string) {
+		DismissExport(p)
+	})
+	p.AddPage(exportKey, modal, false, false)
+	p.ShowPage(exportKey)
+}
+
+// DismissExport dismiss the export dialog.
+func DismissExport(p *tview.Pages) {
+	p.RemovePage(exportKey)
+}
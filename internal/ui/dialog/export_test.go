@@ -0,0 +1,21 @@
+package dialog
+
+import (
+	"testing"
+
+	"github.com/derailed/tview"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExportDialog(t *testing.T) {
+	p := tview.NewPages()
+
+	okFunc := func(ExportOpts) {}
+	ShowExport(p, true, true, okFunc)
+
+	d := p.GetPrimitive(exportKey).(*tview.ModalForm)
+	assert.NotNil(t, d)
+
+	DismissExport(p)
+	assert.Nil(t, p.GetPrimitive(exportKey))
+}
@@ -0,0 +1,77 @@
+package dialog
+
+import (
+	"strings"
+
+	"github.com/derailed/k9s/internal/k8s"
+	"github.com/derailed/tview"
+	"github.com/gdamore/tcell"
+)
+
+const (
+	imageKey        = "image"
+	imageFieldWidth = 60
+)
+
+// ShowImages pops a container images dialog.
+func ShowImages(p *tview.Pages, msg string, ii []k8s.ContainerImage, okFn func([]k8s.ContainerImage)) {
+	f := tview.NewForm()
+	f.SetItemPadding(0)
+	f.SetButtonsAlign(tview.AlignCenter).
+		SetButtonBackgroundColor(tview.Styles.PrimitiveBackgroundColor).
+		SetButtonTextColor(tview.Styles.PrimaryTextColor).
+		SetLabelColor(tcell.ColorAqua).
+		SetFieldTextColor(tcell.ColorOrange)
+
+	edits := make([]k8s.ContainerImage, len(ii))
+	copy(edits, ii)
+	for i := range edits {
+		index := i
+		f.AddInputField(imageLabel(edits[i]), edits[i].Image, imageFieldWidth, nil, func(s string) {
+			edits[index].Image = strings.TrimSpace(s)
+		})
+	}
+
+	f.AddButton("OK", func() {
+		DismissImages(p)
+		okFn(changedImages(ii, edits))
+	})
+	f.AddButton("Cancel", func() {
+		DismissImages(p)
+	})
+
+	modal := tview.NewModalForm("<Set Image>", f)
+	modal.SetText(msg)
+	modal.SetDoneFunc(func(_ int, b string) {
+		DismissImages(p)
+	})
+	p.AddPage(imageKey, modal, false, false)
+	p.ShowPage(imageKey)
+}
+
+// DismissImages dismiss the container images dialog.
+func DismissImages(p *tview.Pages) {
+	p.RemovePage(imageKey)
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func imageLabel(i k8s.ContainerImage) string {
+	if i.Init {
+		return i.Name + " (init):"
+	}
+
+	return i.Name + ":"
+}
+
+func changedImages(ii, edits []k8s.ContainerImage) []k8s.ContainerImage {
+	cc := make([]k8s.ContainerImage, 0, len(edits))
+	for i, e := range edits {
+		if e.Image != ii[i].Image {
+			cc = append(cc, e)
+		}
+	}
+
+	return cc
+}
@@ -0,0 +1,35 @@
+package dialog
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/k8s"
+	"github.com/derailed/tview"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestImagesDialog(t *testing.T) {
+	p := tview.NewPages()
+
+	ii := []k8s.ContainerImage{{Name: "i1", Image: "busybox", Init: true}, {Name: "c1", Image: "fred:1"}}
+	ShowImages(p, "Set image for default/fred", ii, func([]k8s.ContainerImage) {})
+
+	d := p.GetPrimitive(imageKey).(*tview.ModalForm)
+	assert.NotNil(t, d)
+
+	DismissImages(p)
+	assert.Nil(t, p.GetPrimitive(imageKey))
+}
+
+func TestChangedImages(t *testing.T) {
+	ii := []k8s.ContainerImage{{Name: "i1", Image: "busybox", Init: true}, {Name: "c1", Image: "fred:1"}, {Name: "c2", Image: "blee:1"}}
+	edits := []k8s.ContainerImage{{Name: "i1", Image: "busybox", Init: true}, {Name: "c1", Image: "fred:2"}, {Name: "c2", Image: "blee:1"}}
+
+	assert.Equal(t, []k8s.ContainerImage{{Name: "c1", Image: "fred:2"}}, changedImages(ii, edits))
+	assert.Equal(t, []k8s.ContainerImage{}, changedImages(ii, ii))
+}
+
+func TestImageLabel(t *testing.T) {
+	assert.Equal(t, "c1:", imageLabel(k8s.ContainerImage{Name: "c1"}))
+	assert.Equal(t, "i1 (init):", imageLabel(k8s.ContainerImage{Name: "i1", Init: true}))
+}
@@ -0,0 +1,46 @@
+package dialog
+
+import (
+	"github.com/derailed/tview"
+	"github.com/gdamore/tcell"
+)
+
+const logWindowKey = "logwindow"
+
+// ShowLogWindow pops a log time window configuration dialog.
+func ShowLogWindow(p *tview.Pages, since, until string, okFn func(since, until string)) {
+	f := tview.NewForm()
+	f.SetItemPadding(0)
+	f.SetButtonsAlign(tview.AlignCenter).
+		SetButtonBackgroundColor(tview.Styles.PrimitiveBackgroundColor).
+		SetButtonTextColor(tview.Styles.PrimaryTextColor).
+		SetLabelColor(tcell.ColorAqua).
+		SetFieldTextColor(tcell.ColorOrange)
+
+	f.AddInputField("Since:", since, 25, nil, func(s string) {
+		since = s
+	})
+	f.AddInputField("Until:", until, 25, nil, func(s string) {
+		until = s
+	})
+
+	f.AddButton("OK", func() {
+		okFn(since, until)
+	})
+	f.AddButton("Cancel", func() {
+		DismissLogWindow(p)
+	})
+
+	modal := tview.NewModalForm("<Log Window>", f)
+	modal.SetText("Since 10m, 2019-10-01T10:00Z... Until is optional.")
+	modal.SetDoneFunc(func(_ int, b string) {
+		DismissLogWindow(p)
+	})
+	p.AddPage(logWindowKey, modal, false, false)
+	p.ShowPage(logWindowKey)
+}
+
+// DismissLogWindow dismiss the log window dialog.
+func DismissLogWindow(p *tview.Pages) {
+	p.RemovePage(logWindowKey)
+}
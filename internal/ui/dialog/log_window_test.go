@@ -0,0 +1,22 @@
+package dialog
+
+import (
+	"testing"
+
+	"github.com/derailed/tview"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLogWindowDialog(t *testing.T) {
+	p := tview.NewPages()
+
+	okFunc := func(since, until string) {
+	}
+	ShowLogWindow(p, "10m", "", okFunc)
+
+	d := p.GetPrimitive(logWindowKey).(*tview.ModalForm)
+	assert.NotNil(t, d)
+
+	DismissLogWindow(p)
+	assert.Nil(t, p.GetPrimitive(logWindowKey))
+}
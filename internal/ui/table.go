@@ -126,20 +126,35 @@ func (v *Table) SelectRow(r int, broadcast bool) {
 	v.updateSelectedItem(r)
 }
 
+// SelectItem selects the row matching the given item if any.
+func (v *Table) SelectItem(item string) bool {
+	for r := 1; r < v.GetRowCount(); r++ {
+		if v.itemAt(r) == item {
+			v.SelectRow(r, true)
+			return true
+		}
+	}
+
+	return false
+}
+
 func (v *Table) updateSelectedItem(r int) {
+	v.selectedItem = v.itemAt(r)
+}
+
+func (v *Table) itemAt(r int) string {
 	if r == 0 || v.GetCell(r, 0) == nil {
-		v.selectedItem = ""
-		return
+		return ""
 	}
 
 	col0 := TrimCell(v, r, 0)
 	switch v.activeNS {
 	case resource.NotNamespaced:
-		v.selectedItem = col0
+		return col0
 	case resource.AllNamespace, resource.AllNamespaces:
-		v.selectedItem = path.Join(col0, TrimCell(v, r, 1))
+		return path.Join(col0, TrimCell(v, r, 1))
 	default:
-		v.selectedItem = path.Join(v.activeNS, col0)
+		return path.Join(v.activeNS, col0)
 	}
 }
 
@@ -553,6 +568,50 @@ func (v *Table) ToggleMark() {
 	v.marks[v.GetSelectedItem()] = !v.marks[v.GetSelectedItem()]
 }
 
+// HasMarks checks if any rows are marked.
+func (v *Table) HasMarks() bool {
+	for _, m := range v.marks {
+		if m {
+			return true
+		}
+	}
+
+	return false
+}
+
+// GetMarkedData returns the filtered table data restricted to marked rows.
+func (v *Table) GetMarkedData() resource.TableData {
+	data := v.filtered()
+	marked := resource.TableData{
+		Header:    data.Header,
+		Rows:      make(resource.RowEvents, len(v.marks)),
+		NumCols:   data.NumCols,
+		Namespace: data.Namespace,
+	}
+	for k, r := range data.Rows {
+		if v.isMarked(k) {
+			marked.Rows[k] = r
+		}
+	}
+
+	return marked
+}
+
+// GetSortedKeys returns the data row keys in display order.
+func (v *Table) GetSortedKeys(data resource.TableData) []string {
+	sortFn := defaultSort
+	if v.sortFn != nil {
+		sortFn = v.sortFn
+	}
+	prim, sec := sortAllRows(v.sortCol, data.Rows, sortFn)
+	keys := make([]string, 0, len(data.Rows))
+	for _, pk := range prim {
+		keys = append(keys, sec[pk]...)
+	}
+
+	return keys
+}
+
 func (v *Table) isMarked(item string) bool {
 	return v.marks[item]
 }
@@ -31,8 +31,12 @@ type (
 		Stop()
 		Path() string
 		Container() string
+		Profile() string
+		Selector() string
 		Ports() []string
 		Active() bool
+		Reconnects() int
+		LastError() string
 		Age() string
 	}
 
@@ -54,6 +58,7 @@ type (
 		informer   *watch.Informer
 		stopCh     chan struct{}
 		forwarders map[string]forwarder
+		starting   map[string]bool
 		version    string
 		showHeader bool
 		filter     string
@@ -65,6 +70,7 @@ func NewApp(cfg *config.Config) *appView {
 	v := appView{
 		App:        ui.NewApp(),
 		forwarders: make(map[string]forwarder),
+		starting:   make(map[string]bool),
 	}
 	v.Config = cfg
 	v.InitBench(cfg.K9s.CurrentCluster)
@@ -95,6 +101,7 @@ func (a *appView) Init(version string, rate int) {
 			log.Info().Msg("No namespace specified using all namespaces")
 		}
 		a.startInformer(ns)
+		a.autoStartForwards()
 		a.clusterInfo().init(version)
 		if a.Config.K9s.GetHeadless() {
 			a.refreshIndicator()
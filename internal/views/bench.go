@@ -7,6 +7,7 @@ import (
 	"os"
 	"path/filepath"
 	"regexp"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
@@ -25,15 +26,22 @@ const (
 	benchTitleFmt = " [seagreen::b]%s([fuchsia::b]%d[fuchsia::-])[seagreen::-] "
 )
 
+const (
+	benchReportCol = 9
+	benchAgeCol    = 10
+)
+
+// Legacy benchmarks only recorded hey's text report.
 var (
-	totalRx     = regexp.MustCompile(`Total:\s+([0-9.]+)\ssecs`)
-	reqRx       = regexp.MustCompile(`Requests/sec:\s+([0-9.]+)`)
-	okRx        = regexp.MustCompile(`\[2\d{2}\]\s+(\d+)\s+responses`)
-	errRx       = regexp.MustCompile(`\[[4-5]\d{2}\]\s+(\d+)\s+responses`)
-	toastRx     = regexp.MustCompile(`Error distribution`)
-	benchHeader = resource.Row{"NAMESPACE", "NAME", "STATUS", "TIME", "REQ/S", "2XX", "4XX/5XX", "REPORT", "AGE"}
+	totalRx = regexp.MustCompile(`Total:\s+([0-9.]+)\ssecs`)
+	reqRx   = regexp.MustCompile(`Requests/sec:\s+([0-9.]+)`)
+	okRx    = regexp.MustCompile(`\[2\d{2}\]\s+(\d+)\s+responses`)
+	errRx   = regexp.MustCompile(`\[[4-5]\d{2}\]\s+(\d+)\s+responses`)
+	toastRx = regexp.MustCompile(`Error distribution`)
 )
 
+var benchHeader = resource.Row{"NAMESPACE", "NAME", "STATUS", "TIME", "REQ/S", "2XX", "4XX/5XX", "P95", "P99", "REPORT", "AGE"}
+
 type benchView struct {
 	*masterDetail
 
@@ -43,6 +51,7 @@ type benchView struct {
 func newBenchView(title, gvr string, app *appView, _ resource.List) resourceViewer {
 	v := benchView{app: app}
 	v.masterDetail = newMasterDetail(benchTitle, "", app, v.backCmd)
+	v.AddPage("chart", newBenchChart(app, v.backCmd, v.reportCmd), true, false)
 	v.keyBindings()
 
 	return &v
@@ -56,6 +65,7 @@ func (v *benchView) Init(ctx context.Context, ns string) {
 	tv.SetBorderFocusColor(tcell.ColorSeaGreen)
 	tv.SetSelectedStyle(tcell.ColorWhite, tcell.ColorSeaGreen, tcell.AttrNone)
 	tv.SetColorerFn(benchColorer)
+	tv.SetSelectedFn(v.selectedRun)
 
 	dv := v.detailsPage()
 	dv.setCategory("Bench")
@@ -66,7 +76,7 @@ func (v *benchView) Init(ctx context.Context, ns string) {
 	}
 
 	v.refresh()
-	tv.SetSortCol(tv.NameColIndex()+7, 0, true)
+	tv.SetSortCol(benchAgeCol, 0, true)
 	tv.Refresh()
 	tv.Select(1, 0)
 	v.app.SetFocus(tv)
@@ -89,6 +99,8 @@ func (v *benchView) keyBindings() {
 		ui.KeyP:        ui.NewKeyAction("Previous", v.app.prevCmd, false),
 		tcell.KeyEnter: ui.NewKeyAction("Enter", v.enterCmd, false),
 		tcell.KeyCtrlD: ui.NewKeyAction("Delete", v.deleteCmd, false),
+		ui.KeySpace:    ui.NewKeyAction("Mark", v.markCmd, true),
+		ui.KeyC:        ui.NewKeyAction("Compare", v.compareCmd, true),
 	}
 	v.masterPage().SetActions(aa)
 }
@@ -116,14 +128,73 @@ func (v *benchView) enterCmd(evt *tcell.EventKey) *tcell.EventKey {
 		return nil
 	}
 
+	f := v.benchFile()
+	rep, err := perf.LoadReport(filepath.Join(benchDir(v.app.Config), strings.TrimSuffix(f, perf.ReportExt)+perf.RecordExt))
+	if os.IsNotExist(err) {
+		// Legacy runs only have a text report.
+		return v.reportCmd(evt)
+	}
+	if err != nil {
+		v.app.Flash().Errf("Unable to load bench record %s", err)
+		return nil
+	}
+	vu := v.chartPage()
+	vu.update(v.selectedName(), rep)
+	v.SwitchToPage("chart")
+	v.app.SetHints(vu.Hints())
+
+	return nil
+}
+
+func (v *benchView) reportCmd(evt *tcell.EventKey) *tcell.EventKey {
 	data, err := readBenchFile(v.app.Config, v.benchFile())
 	if err != nil {
 		v.app.Flash().Errf("Unable to load bench file %s", err)
 		return nil
 	}
 	vu := v.detailsPage()
+	vu.setCategory("Bench")
 	vu.SetText(data)
-	vu.setTitle(v.masterPage().GetSelectedItem())
+	vu.setTitle(v.selectedName())
+	vu.ScrollToBeginning()
+	v.showDetails()
+	v.app.SetHints(vu.hints())
+
+	return nil
+}
+
+func (v *benchView) markCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if !v.masterPage().RowSelected() {
+		return evt
+	}
+
+	v.masterPage().ToggleMark()
+	v.refresh()
+
+	return nil
+}
+
+func (v *benchView) compareCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if !v.masterPage().RowSelected() {
+		return evt
+	}
+
+	ff := v.masterPage().GetSelectedItems()
+	if len(ff) < 2 {
+		v.app.Flash().Warn("Mark at least two benchmarks to compare")
+		return nil
+	}
+
+	runs, err := loadRuns(benchDir(v.app.Config), ff)
+	if err != nil {
+		v.app.Flash().Errf("Unable to load bench record %s", err)
+		return nil
+	}
+	vu := v.detailsPage()
+	vu.setCategory("Compare")
+	vu.SetText(compareRuns(runs, v.app.Styles.Frame().Status))
+	vu.setTitle(fmt.Sprintf("%d runs", len(runs)))
+	vu.ScrollToBeginning()
 	v.showDetails()
 
 	return nil
@@ -134,13 +205,18 @@ func (v *benchView) deleteCmd(evt *tcell.EventKey) *tcell.EventKey {
 		return nil
 	}
 
-	sel, file := v.masterPage().GetSelectedItem(), v.benchFile()
+	sel, file := v.selectedName(), v.benchFile()
 	dir := filepath.Join(perf.K9sBenchDir, v.app.Config.K9s.CurrentCluster)
 	showModal(v.Pages, fmt.Sprintf("Delete benchmark `%s?", file), "master", func() {
 		if err := os.Remove(filepath.Join(dir, file)); err != nil {
 			v.app.Flash().Errf("Unable to delete file %s", err)
 			return
 		}
+		rec := filepath.Join(dir, strings.TrimSuffix(file, perf.ReportExt)+perf.RecordExt)
+		if err := os.Remove(rec); err != nil && !os.IsNotExist(err) {
+			v.app.Flash().Errf("Unable to delete file %s", err)
+			return
+		}
 		v.app.Flash().Infof("Benchmark %s deleted!", sel)
 	})
 
@@ -149,12 +225,30 @@ func (v *benchView) deleteCmd(evt *tcell.EventKey) *tcell.EventKey {
 
 func (v *benchView) backCmd(evt *tcell.EventKey) *tcell.EventKey {
 	v.showMaster()
+	v.app.SetHints(v.masterPage().Hints())
+
 	return nil
 }
 
+func (v *benchView) chartPage() *benchChart {
+	return v.GetPrimitive("chart").(*benchChart)
+}
+
+// SelectedRun returns the selected benchmark report file.
+func (v *benchView) selectedRun(string) string {
+	return v.benchFile()
+}
+
+func (v *benchView) selectedName() string {
+	tv := v.masterPage()
+	r := tv.GetSelectedRow()
+
+	return fqn(ui.TrimCell(tv.Table, r, 0), ui.TrimCell(tv.Table, r, 1))
+}
+
 func (v *benchView) benchFile() string {
 	r := v.masterPage().GetSelectedRow()
-	return ui.TrimCell(v.masterPage().Table, r, 7)
+	return ui.TrimCell(v.masterPage().Table, r, benchReportCol)
 }
 
 func (v *benchView) hints() ui.Hints {
@@ -167,20 +261,42 @@ func (v *benchView) hydrate() resource.TableData {
 		v.app.Flash().Errf("Unable to read bench directory %s", err)
 	}
 
+	dir, recs := benchDir(v.app.Config), make(map[string]bool, len(ff))
+	for _, f := range ff {
+		if filepath.Ext(f.Name()) == perf.RecordExt {
+			recs[strings.TrimSuffix(f.Name(), perf.RecordExt)] = true
+		}
+	}
+
 	data := initTable()
 	for _, f := range ff {
-		bench, err := readBenchFile(v.app.Config, f.Name())
-		if err != nil {
-			log.Error().Err(err).Msgf("Unable to load bench file %s", f.Name())
+		fields := make(resource.Row, len(benchHeader))
+		switch filepath.Ext(f.Name()) {
+		case perf.RecordExt:
+			rep, err := perf.LoadReport(filepath.Join(dir, f.Name()))
+			if err != nil {
+				log.Error().Err(err).Msgf("Unable to load bench record %s", f.Name())
+				continue
+			}
+			augmentRow(fields, rep)
+		case perf.ReportExt:
+			if recs[strings.TrimSuffix(f.Name(), perf.ReportExt)] {
+				continue
+			}
+			raw, err := ioutil.ReadFile(filepath.Join(dir, f.Name()))
+			if err != nil {
+				log.Error().Err(err).Msgf("Unable to load bench file %s", f.Name())
+				continue
+			}
+			augmentLegacyRow(fields, string(raw))
+		default:
 			continue
 		}
-		fields := make(resource.Row, len(benchHeader))
 		if err := initRow(fields, f); err != nil {
 			log.Error().Err(err).Msg("Load bench file")
 			continue
 		}
-		augmentRow(fields, bench)
-		data.Rows[f.Name()] = &resource.RowEvent{
+		data.Rows[fields[benchReportCol]] = &resource.RowEvent{
 			Action: resource.New,
 			Fields: fields,
 			Deltas: fields,
@@ -197,8 +313,8 @@ func initRow(row resource.Row, f os.FileInfo) error {
 	}
 	row[0] = tokens[0]
 	row[1] = tokens[1]
-	row[7] = f.Name()
-	row[8] = time.Since(f.ModTime()).String()
+	row[benchReportCol] = strings.TrimSuffix(f.Name(), filepath.Ext(f.Name())) + perf.ReportExt
+	row[benchAgeCol] = time.Since(f.ModTime()).String()
 
 	return nil
 }
@@ -243,60 +359,108 @@ func initTable() resource.TableData {
 			benchHeader[4]: true,
 			benchHeader[5]: true,
 			benchHeader[6]: true,
+			benchHeader[7]: true,
+			benchHeader[8]: true,
 		},
 		Namespace: resource.AllNamespaces,
 	}
 }
 
-func augmentRow(fields resource.Row, data string) {
+func augmentRow(fields resource.Row, r perf.Report) {
+	col := 2
+	fields[col] = "pass"
+	if !r.Pass() {
+		fields[col] = "fail"
+	}
+	col++
+
+	fields[col] = asFloat(r.Total)
+	col++
+	fields[col] = asFloat(r.RPS)
+	col++
+	fields[col] = asNum(r.OK())
+	col++
+	fields[col] = asNum(r.Failed())
+	col++
+	fields[col] = asFloat(r.Percentile(95))
+	col++
+	fields[col] = asFloat(r.Percentile(99))
+}
+
+// AugmentLegacyRow extracts a run stats from a text only report.
+func augmentLegacyRow(fields resource.Row, data string) {
 	if len(data) == 0 {
 		return
 	}
 
 	col := 2
 	fields[col] = "pass"
-	mf := toastRx.FindAllStringSubmatch(data, 1)
-	if len(mf) > 0 {
+	if toastRx.MatchString(data) {
 		fields[col] = "fail"
 	}
 	col++
 
-	mt := totalRx.FindAllStringSubmatch(data, 1)
-	if len(mt) > 0 {
-		fields[col] = mt[0][1]
+	if mt := totalRx.FindStringSubmatch(data); len(mt) > 0 {
+		fields[col] = mt[1]
 	}
 	col++
-
-	mr := reqRx.FindAllStringSubmatch(data, 1)
-	if len(mr) > 0 {
-		fields[col] = mr[0][1]
+	if mr := reqRx.FindStringSubmatch(data); len(mr) > 0 {
+		fields[col] = mr[1]
 	}
 	col++
+	fields[col] = asNum(sumMatches(okRx, data))
+	col++
+	fields[col] = asNum(sumMatches(errRx, data))
+}
 
-	ms := okRx.FindAllStringSubmatch(data, -1)
-	fields[col] = "0"
-	if len(ms) > 0 {
-		var sum int
-		for _, m := range ms {
-			if m, err := strconv.Atoi(string(m[1])); err == nil {
-				sum += m
-			}
+func sumMatches(rx *regexp.Regexp, data string) int {
+	var sum int
+	for _, m := range rx.FindAllStringSubmatch(data, -1) {
+		if n, err := strconv.Atoi(m[1]); err == nil {
+			sum += n
 		}
-		fields[col] = asNum(sum)
 	}
-	col++
 
-	me := errRx.FindAllStringSubmatch(data, -1)
-	fields[col] = "0"
-	if len(me) > 0 {
-		var sum int
-		for _, m := range me {
-			if m, err := strconv.Atoi(string(m[1])); err == nil {
-				sum += m
-			}
+	return sum
+}
+
+// AsFloat prints a decimal number using 4 digits precision.
+func asFloat(f float64) string {
+	return strconv.FormatFloat(f, 'f', 4, 64)
+}
+
+// LoadRuns loads benchmark records for the given reports, oldest first.
+func loadRuns(dir string, ff []string) ([]benchRun, error) {
+	sort.Strings(ff)
+	sort.SliceStable(ff, func(i, j int) bool {
+		return runTime(ff[i]).Before(runTime(ff[j]))
+	})
+
+	runs := make([]benchRun, 0, len(ff))
+	for _, f := range ff {
+		rec := strings.TrimSuffix(f, perf.ReportExt) + perf.RecordExt
+		r, err := perf.LoadReport(filepath.Join(dir, rec))
+		if err != nil {
+			return nil, err
 		}
-		fields[col] = asNum(sum)
+		runs = append(runs, benchRun{
+			label:  r.Name + "@" + runTime(f).Format("01-02 15:04:05"),
+			report: r,
+		})
 	}
+
+	return runs, nil
+}
+
+// RunTime extracts a benchmark run time from its file name.
+func runTime(f string) time.Time {
+	tokens := strings.Split(strings.TrimSuffix(filepath.Base(f), filepath.Ext(f)), "_")
+	n, err := strconv.ParseInt(tokens[len(tokens)-1], 10, 64)
+	if err != nil {
+		return time.Time{}
+	}
+
+	return time.Unix(0, n)
 }
 
 func benchDir(cfg *config.Config) string {
@@ -0,0 +1,204 @@
+package views
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/perf"
+	"github.com/derailed/k9s/internal/resource"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tview"
+	"github.com/gdamore/tcell"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	chartBarWidth  = 50
+	chartBarChar   = "█"
+	chartTitleFmt  = " [aqua::b]%s([fuchsia::b]%s[aqua::-]) "
+	timelineHeight = 7
+)
+
+var sparks = []rune("▁▂▃▄▅▆▇█")
+
+// BenchChart renders a benchmark latency histogram and request timeline.
+type benchChart struct {
+	*tview.Flex
+
+	app      *appView
+	actions  ui.KeyActions
+	hist     *tview.TextView
+	timeline *tview.TextView
+}
+
+func newBenchChart(app *appView, backFn, reportFn ui.ActionHandler) *benchChart {
+	v := benchChart{
+		Flex:     tview.NewFlex(),
+		app:      app,
+		hist:     newChartView("Latency"),
+		timeline: newChartView("Requests/sec"),
+	}
+	v.SetDirection(tview.FlexRow)
+	v.SetBorder(true)
+	v.SetBorderPadding(0, 0, 1, 1)
+	v.SetBorderFocusColor(config.AsColor(app.Styles.Frame().Border.FocusColor))
+	v.AddItem(v.hist, 0, 1, false)
+	v.AddItem(v.timeline, timelineHeight, 0, false)
+	v.SetInputCapture(v.keyboard)
+	v.actions = ui.KeyActions{
+		tcell.KeyEscape: ui.NewKeyAction("Back", backFn, true),
+		ui.KeyR:         ui.NewKeyAction("Report", reportFn, true),
+	}
+
+	return &v
+}
+
+func newChartView(title string) *tview.TextView {
+	v := tview.NewTextView()
+	v.SetDynamicColors(true)
+	v.SetWrap(false)
+	v.SetBorder(true)
+	v.SetTitle(" " + title + " ")
+	v.SetTitleAlign(tview.AlignLeft)
+	v.SetTitleColor(tcell.ColorAqua)
+
+	return v
+}
+
+func (v *benchChart) keyboard(evt *tcell.EventKey) *tcell.EventKey {
+	key := evt.Key()
+	if key == tcell.KeyRune {
+		key = tcell.Key(evt.Rune())
+	}
+
+	if a, ok := v.actions[key]; ok {
+		log.Debug().Msgf(">> BenchChart handled %s", tcell.KeyNames[key])
+		return a.Action(evt)
+	}
+
+	return evt
+}
+
+// Hints fetch menu hints.
+func (v *benchChart) Hints() ui.Hints {
+	return v.actions.Hints()
+}
+
+func (v *benchChart) update(name string, r perf.Report) {
+	st := v.app.Styles.Frame().Status
+	v.SetTitle(fmt.Sprintf(chartTitleFmt, "Bench", name))
+	text := chartSummary(r, st) + "\n\n"
+	if len(r.Steps) > 0 {
+		text += stepsChart(r.Steps, st) + "\n\n"
+	}
+	v.hist.SetText(text + histogramChart(r.Histogram, chartBarWidth, st.NewColor))
+	v.timeline.SetText(timelineChart(r.Timeline, st.HighlightColor))
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// ChartSummary renders a benchmark key metrics.
+func chartSummary(r perf.Report, st config.Status) string {
+	return fmt.Sprintf(
+		"[%s::b]Requests:[-::-] %d  [%s::b]Req/s:[-::-] %s  [%s::b]P50:[-::-] %s  [%s::b]P90:[-::-] %s  [%s::b]P99:[-::-] %s  [%s::b]Errors:[-::-] %s",
+		st.HighlightColor, r.Requests,
+		st.HighlightColor, asFloat(r.RPS),
+		st.HighlightColor, asFloat(r.Percentile(50)),
+		st.HighlightColor, asFloat(r.Percentile(90)),
+		st.HighlightColor, asFloat(r.Percentile(99)),
+		st.HighlightColor, asPerc(r.ErrorRate()),
+	)
+}
+
+// StepsChart renders scenario per step stats.
+func stepsChart(ss []perf.StepReport, st config.Status) string {
+	buff := new(bytes.Buffer)
+	fmt.Fprintf(buff, "[%s::b]%-20s %10s %10s %10s %10s %10s %8s %8s %8s[-::-]\n",
+		st.HighlightColor, "STEP", "REQUESTS", "REQ/S", "AVG", "P50", "P99", "2XX", "4XX/5XX", "ERRORS")
+	for _, s := range ss {
+		color := st.NewColor
+		if s.Failed() > 0 || s.ErrorCount() > 0 {
+			color = st.ErrorColor
+		}
+		fmt.Fprintf(buff, "[%s::]%-20s %10d %10s %10s %10s %10s %8d %8d %8d[-::]\n",
+			color, resource.Truncate(s.Name, 20), s.Requests, asFloat(s.RPS), asFloat(s.Average),
+			asFloat(s.Percentile(50)), asFloat(s.Percentile(99)), s.OK(), s.Failed(), s.ErrorCount())
+	}
+
+	return strings.TrimSuffix(buff.String(), "\n")
+}
+
+// HistogramChart renders latency buckets as horizontal bars scaled to width.
+func histogramChart(bb []perf.Bucket, width int, color string) string {
+	if len(bb) == 0 {
+		return "No latency histogram available"
+	}
+
+	var max int
+	for _, b := range bb {
+		if b.Count > max {
+			max = b.Count
+		}
+	}
+
+	buff := new(bytes.Buffer)
+	for _, b := range bb {
+		var n int
+		if max > 0 {
+			n = (b.Count*width + max/2) / max
+		}
+		if n == 0 && b.Count > 0 {
+			n = 1
+		}
+		fmt.Fprintf(buff, "%9ss %8d [%s::]%s[-::]\n", asFloat(b.Mark), b.Count, color, strings.Repeat(chartBarChar, n))
+	}
+
+	return strings.TrimSuffix(buff.String(), "\n")
+}
+
+// TimelineChart renders a request rate sparkline over the run duration.
+func timelineChart(t perf.Timeline, color string) string {
+	rr := t.Rates()
+	if len(rr) == 0 {
+		return "No request timeline available"
+	}
+
+	var max float64
+	for _, r := range rr {
+		if r > max {
+			max = r
+		}
+	}
+
+	return fmt.Sprintf(
+		"Max: %s/s\n\n[%s::]%s[-::]\n0s%*ss",
+		asFloat(max),
+		color, sparkline(rr),
+		len(rr)-3, asFloat(t.Step*float64(len(rr))),
+	)
+}
+
+// Sparkline renders values as a single line of block characters. Empty slices
+// are left blank so stalls stand out.
+func sparkline(vv []float64) string {
+	var max float64
+	for _, v := range vv {
+		if v > max {
+			max = v
+		}
+	}
+
+	rr := make([]rune, len(vv))
+	for i, v := range vv {
+		if v <= 0 || max == 0 {
+			rr[i] = ' '
+			continue
+		}
+		rr[i] = sparks[int(v/max*float64(len(sparks)-1)+0.5)]
+	}
+
+	return string(rr)
+}
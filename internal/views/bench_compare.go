@@ -0,0 +1,114 @@
+package views
+
+import (
+	"bytes"
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/perf"
+	"github.com/derailed/k9s/internal/resource"
+)
+
+const (
+	compareColWidth  = 28
+	compareTolerance = 1.0
+)
+
+type (
+	// BenchRun tracks a benchmark record and its label.
+	benchRun struct {
+		label  string
+		report perf.Report
+	}
+
+	// BenchMetric describes a compared benchmark metric.
+	benchMetric struct {
+		name         string
+		value        func(perf.Report) float64
+		format       func(float64) string
+		higherBetter bool
+	}
+)
+
+var benchMetrics = []benchMetric{
+	{name: "REQ/S", value: func(r perf.Report) float64 { return r.RPS }, format: asFloat, higherBetter: true},
+	{name: "P50", value: func(r perf.Report) float64 { return r.Percentile(50) }, format: asFloat},
+	{name: "P90", value: func(r perf.Report) float64 { return r.Percentile(90) }, format: asFloat},
+	{name: "P99", value: func(r perf.Report) float64 { return r.Percentile(99) }, format: asFloat},
+	{name: "ERROR RATE", value: func(r perf.Report) float64 { return r.ErrorRate() }, format: asPerc},
+}
+
+// CompareRuns renders a side by side comparison of benchmark runs. Deltas are
+// computed against the first run.
+func compareRuns(runs []benchRun, st config.Status) string {
+	buff := new(bytes.Buffer)
+	if len(runs) == 0 {
+		return ""
+	}
+
+	fmt.Fprintf(buff, "[%s::b]%-12s", st.HighlightColor, "METRIC")
+	for _, r := range runs {
+		fmt.Fprintf(buff, "%-*s", compareColWidth, resource.Truncate(r.label, compareColWidth-1))
+	}
+	fmt.Fprintln(buff, "[-::-]")
+
+	for _, m := range benchMetrics {
+		base := m.value(runs[0].report)
+		fmt.Fprintf(buff, "[%s::b]%-12s[-::-]", st.HighlightColor, m.name)
+		for i, r := range runs {
+			v := m.value(r.report)
+			cell := m.format(v)
+			if i == 0 {
+				fmt.Fprintf(buff, "%-*s", compareColWidth, cell)
+				continue
+			}
+			cell += " (" + asDelta(base, v) + ")"
+			fmt.Fprintf(buff, "[%s::]%-*s[-::]", deltaColor(base, v, m.higherBetter, st), compareColWidth, cell)
+		}
+		fmt.Fprintln(buff)
+	}
+
+	return strings.TrimSpace(buff.String())
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func delta(base, v float64) (float64, bool) {
+	if base == 0 {
+		return 0, v == 0
+	}
+
+	return (v - base) / base * 100, true
+}
+
+func asDelta(base, v float64) string {
+	d, ok := delta(base, v)
+	if !ok {
+		return "n/a"
+	}
+
+	return fmt.Sprintf("%+.1f%%", d)
+}
+
+func deltaColor(base, v float64, higherBetter bool, st config.Status) string {
+	d, ok := delta(base, v)
+	if !ok {
+		d = math.Copysign(math.Inf(1), v-base)
+	}
+	if math.Abs(d) < compareTolerance {
+		return st.CompletedColor
+	}
+	if (d > 0) == higherBetter {
+		return st.ModifyColor
+	}
+
+	return st.ErrorColor
+}
+
+// AsPerc prints a ratio as a percentage.
+func asPerc(f float64) string {
+	return fmt.Sprintf("%.2f%%", f*100)
+}
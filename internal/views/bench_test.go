@@ -2,43 +2,217 @@ package views
 
 import (
 	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/perf"
 	"github.com/derailed/k9s/internal/resource"
 	"github.com/stretchr/testify/assert"
 )
 
 func TestAugmentRow(t *testing.T) {
+	lats := []perf.Latency{{Percentile: 95, Secs: 0.12}, {Percentile: 99, Secs: 0.25}}
 	uu := map[string]struct {
-		file string
-		e    resource.Row
+		r perf.Report
+		e resource.Row
 	}{
 		"cool": {
-			"test_assets/b1.txt",
-			resource.Row{"pass", "3.3544", "29.8116", "100", "0"},
+			perf.Report{Total: 3.3544, RPS: 29.8116, Latencies: lats, StatusCodes: map[int]int{200: 100}},
+			resource.Row{"pass", "3.3544", "29.8116", "100", "0", "0.1200", "0.2500"},
 		},
 		"2XX": {
-			"test_assets/b4.txt",
-			resource.Row{"pass", "3.3544", "29.8116", "160", "0"},
+			perf.Report{Total: 3.3544, RPS: 29.8116, Latencies: lats, StatusCodes: map[int]int{200: 100, 201: 60}},
+			resource.Row{"pass", "3.3544", "29.8116", "160", "0", "0.1200", "0.2500"},
 		},
 		"4XX/5XX": {
-			"test_assets/b2.txt",
-			resource.Row{"pass", "3.3544", "29.8116", "100", "12"},
+			perf.Report{Total: 3.3544, RPS: 29.8116, Latencies: lats, StatusCodes: map[int]int{200: 100, 404: 10, 500: 2}},
+			resource.Row{"pass", "3.3544", "29.8116", "100", "12", "0.1200", "0.2500"},
 		},
 		"toast": {
-			"test_assets/b3.txt",
-			resource.Row{"fail", "2.3688", "35.4606", "0", "0"},
+			perf.Report{Total: 2.3688, RPS: 35.4606, Errors: map[string]int{"dial tcp: connection refused": 84}},
+			resource.Row{"fail", "2.3688", "35.4606", "0", "0", "0.0000", "0.0000"},
 		},
 	}
 
+	for k, u := range uu {
+		t.Run(k, func(t *testing.T) {
+			fields := make(resource.Row, len(benchHeader))
+			augmentRow(fields, u.r)
+			assert.Equal(t, u.e, fields[2:9])
+		})
+	}
+}
+
+func TestAugmentLegacyRow(t *testing.T) {
+	uu := map[string]struct {
+		file string
+		e    resource.Row
+	}{
+		"cool":    {"test_assets/b1.txt", resource.Row{"pass", "3.3544", "29.8116", "100", "0", "", ""}},
+		"2XX":     {"test_assets/b4.txt", resource.Row{"pass", "3.3544", "29.8116", "160", "0", "", ""}},
+		"4XX/5XX": {"test_assets/b2.txt", resource.Row{"pass", "3.3544", "29.8116", "100", "12", "", ""}},
+		"toast":   {"test_assets/b3.txt", resource.Row{"fail", "2.3688", "35.4606", "0", "0", "", ""}},
+	}
+
 	for k, u := range uu {
 		t.Run(k, func(t *testing.T) {
 			data, err := ioutil.ReadFile(u.file)
 
 			assert.Nil(t, err)
-			fields := make(resource.Row, 8)
-			augmentRow(fields, string(data))
-			assert.Equal(t, u.e, fields[2:7])
+			fields := make(resource.Row, len(benchHeader))
+			augmentLegacyRow(fields, string(data))
+			assert.Equal(t, u.e, fields[2:9])
+		})
+	}
+}
+
+func TestInitRow(t *testing.T) {
+	dir, err := ioutil.TempDir("", "k9s-bench")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	uu := map[string]struct {
+		f, e string
+	}{
+		"record": {"default_nginx_1571277515000000001.json", "default_nginx_1571277515000000001.txt"},
+		"legacy": {"default_nginx_1571277515000000000.txt", "default_nginx_1571277515000000000.txt"},
+	}
+
+	for k, u := range uu {
+		t.Run(k, func(t *testing.T) {
+			f := filepath.Join(dir, u.f)
+			assert.Nil(t, ioutil.WriteFile(f, nil, 0600))
+			fi, err := os.Stat(f)
+			assert.Nil(t, err)
+
+			row := make(resource.Row, len(benchHeader))
+			assert.Nil(t, initRow(row, fi))
+			assert.Equal(t, resource.Row{"default", "nginx"}, row[:2])
+			assert.Equal(t, u.e, row[benchReportCol])
+		})
+	}
+}
+
+func TestBenchViewEnterLegacy(t *testing.T) {
+	dir, err := ioutil.TempDir("", "k9s-bench")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+	defer func(d string) { perf.K9sBenchDir = d }(perf.K9sBenchDir)
+	perf.K9sBenchDir = dir
+
+	v := newBenchView("Bench", "", NewApp(config.NewConfig(ks{})), nil).(*benchView)
+	data, err := ioutil.ReadFile("test_assets/b1.txt")
+	assert.Nil(t, err)
+	assert.Nil(t, os.MkdirAll(benchDir(v.app.Config), 0700))
+	f := filepath.Join(benchDir(v.app.Config), "default_nginx_1571277515000000000"+perf.ReportExt)
+	assert.Nil(t, ioutil.WriteFile(f, data, 0600))
+
+	v.refresh()
+	v.masterPage().SelectRow(1, true)
+	assert.Nil(t, v.enterCmd(nil))
+
+	n, _ := v.GetFrontPage()
+	assert.Equal(t, "details", n)
+	assert.Contains(t, v.detailsPage().GetText(true), "Requests/sec:")
+}
+
+func TestCompareRuns(t *testing.T) {
+	st := config.Status{
+		HighlightColor: "aqua",
+		ModifyColor:    "greenyellow",
+		ErrorColor:     "orangered",
+		CompletedColor: "gray",
+	}
+	base := perf.Report{
+		RPS:         100,
+		Requests:    100,
+		Latencies:   []perf.Latency{{Percentile: 50, Secs: 0.1}, {Percentile: 90, Secs: 0.2}, {Percentile: 99, Secs: 0.4}},
+		StatusCodes: map[int]int{200: 100},
+	}
+	slower := perf.Report{
+		RPS:         80,
+		Requests:    100,
+		Latencies:   []perf.Latency{{Percentile: 50, Secs: 0.1}, {Percentile: 90, Secs: 0.3}, {Percentile: 99, Secs: 0.2}},
+		StatusCodes: map[int]int{200: 98, 500: 2},
+	}
+
+	out := compareRuns([]benchRun{{"r1", base}, {"r2", slower}}, st)
+	lines := strings.Split(out, "\n")
+
+	assert.Equal(t, 6, len(lines))
+	assert.Contains(t, lines[0], "r1")
+	assert.Contains(t, lines[1], "[orangered::]80.0000 (-20.0%)")
+	assert.Contains(t, lines[2], "[gray::]0.1000 (+0.0%)")
+	assert.Contains(t, lines[3], "[orangered::]0.3000 (+50.0%)")
+	assert.Contains(t, lines[4], "[greenyellow::]0.2000 (-50.0%)")
+	assert.Contains(t, lines[5], "[orangered::]2.00% (n/a)")
+}
+
+func TestRunTime(t *testing.T) {
+	uu := map[string]struct {
+		f string
+		e int64
+	}{
+		"report": {"default_nginx_1571277515000000000.txt", 1571277515000000000},
+		"record": {"default_nginx_1571277515000000001.json", 1571277515000000001},
+		"busted": {"default_nginx.txt", 0},
+	}
+
+	for k, u := range uu {
+		t.Run(k, func(t *testing.T) {
+			tt := runTime(u.f)
+			if u.e == 0 {
+				assert.True(t, tt.IsZero())
+				return
+			}
+			assert.Equal(t, u.e, tt.UnixNano())
 		})
 	}
 }
+
+func TestSparkline(t *testing.T) {
+	uu := map[string]struct {
+		vv []float64
+		e  string
+	}{
+		"empty": {nil, ""},
+		"zeros": {[]float64{0, 0}, "  "},
+		"ramp":  {[]float64{1, 4, 8}, "▂▅█"},
+		"stall": {[]float64{8, 0, 8}, "█ █"},
+	}
+
+	for k, u := range uu {
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, sparkline(u.vv))
+		})
+	}
+}
+
+func TestHistogramChart(t *testing.T) {
+	bb := []perf.Bucket{{Mark: 0.1, Count: 10}, {Mark: 0.2, Count: 0}, {Mark: 0.3, Count: 1}}
+	lines := strings.Split(histogramChart(bb, 10, "green"), "\n")
+
+	assert.Equal(t, 3, len(lines))
+	assert.Equal(t, "   0.1000s       10 [green::]"+strings.Repeat(chartBarChar, 10)+"[-::]", lines[0])
+	assert.Equal(t, "   0.2000s        0 [green::][-::]", lines[1])
+	assert.Equal(t, "   0.3000s        1 [green::]"+chartBarChar+"[-::]", lines[2])
+	assert.Equal(t, "No latency histogram available", histogramChart(nil, 10, "green"))
+}
+
+func TestStepsChart(t *testing.T) {
+	st := config.Status{HighlightColor: "aqua", NewColor: "green", ErrorColor: "red"}
+	ss := []perf.StepReport{
+		{Name: "login", Requests: 2, StatusCodes: map[int]int{200: 2}},
+		{Name: "orders", Requests: 4, StatusCodes: map[int]int{200: 3, 401: 1}},
+	}
+	lines := strings.Split(stepsChart(ss, st), "\n")
+
+	assert.Equal(t, 3, len(lines))
+	assert.True(t, strings.HasPrefix(lines[0], "[aqua::b]STEP"))
+	assert.True(t, strings.HasPrefix(lines[1], "[green::]login"))
+	assert.True(t, strings.HasPrefix(lines[2], "[red::]orders"))
+	assert.Contains(t, lines[2], "       3        1        0")
+}
@@ -9,7 +9,11 @@ import (
 	"k8s.io/apimachinery/pkg/watch"
 )
 
-func forwardColorer(string, *resource.RowEvent) tcell.Color {
+func forwardColorer(_ string, r *resource.RowEvent) tcell.Color {
+	if strings.TrimSpace(r.Fields[ageCol]) == inactiveAge {
+		return tcell.ColorGray
+	}
+
 	return tcell.ColorSkyblue
 }
 
@@ -36,6 +40,36 @@ func rbacColorer(ns string, r *resource.RowEvent) tcell.Color {
 	return ui.DefaultColorer(ns, r)
 }
 
+func searchColorer(ns string, r *resource.RowEvent) tcell.Color {
+	return ui.StdColor
+}
+
+func historyColorer(ns string, r *resource.RowEvent) tcell.Color {
+	if r.Fields[1] == currentRevision {
+		return tcell.ColorPaleGreen
+	}
+
+	return ui.StdColor
+}
+
+func jobColorer(ns string, r *resource.RowEvent) tcell.Color {
+	c := ui.DefaultColorer(ns, r)
+
+	statusCol := 2
+	if len(ns) != 0 {
+		statusCol = 1
+	}
+
+	switch strings.TrimSpace(r.Fields[statusCol]) {
+	case "Failed":
+		return ui.ErrColor
+	case "Complete":
+		return ui.CompletedColor
+	}
+
+	return c
+}
+
 func podColorer(ns string, r *resource.RowEvent) tcell.Color {
 	c := ui.DefaultColorer(ns, r)
 
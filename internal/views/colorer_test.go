@@ -251,6 +251,34 @@ func TestCtxColorer(t *testing.T) {
 	}
 }
 
+func TestJobColorer(t *testing.T) {
+	var (
+		nsRow       = resource.Row{"blee", "fred", "Running", "0/1"}
+		failedNS    = resource.Row{"blee", "fred", "Failed", "0/1"}
+		completeNS  = resource.Row{"blee", "fred", "Complete", "1/1"}
+		row, failed = nsRow[1:], failedNS[1:]
+		complete    = completeNS[1:]
+	)
+
+	uu := colorerUCs{
+		// Add AllNS
+		{"", &resource.RowEvent{Action: watch.Added, Fields: nsRow}, ui.AddColor},
+		// Add Namespaced
+		{"blee", &resource.RowEvent{Action: watch.Added, Fields: row}, ui.AddColor},
+		// Failed AllNS
+		{"", &resource.RowEvent{Action: watch.Modified, Fields: failedNS}, ui.ErrColor},
+		// Failed Namespaced
+		{"blee", &resource.RowEvent{Action: watch.Modified, Fields: failed}, ui.ErrColor},
+		// Complete AllNS
+		{"", &resource.RowEvent{Action: watch.Modified, Fields: completeNS}, ui.CompletedColor},
+		// Complete Namespaced
+		{"blee", &resource.RowEvent{Action: watch.Modified, Fields: complete}, ui.CompletedColor},
+	}
+	for _, u := range uu {
+		assert.Equal(t, u.e, jobColorer(u.ns, u.r))
+	}
+}
+
 func TestPodColorer(t *testing.T) {
 	var (
 		nsRow                = resource.Row{"blee", "fred", "1/1", "Running"}
@@ -281,3 +309,19 @@ func TestPodColorer(t *testing.T) {
 		assert.Equal(t, u.e, podColorer(u.ns, u.r))
 	}
 }
+
+func TestForwardColorer(t *testing.T) {
+	var (
+		active   = resource.Row{"default", "p1", "c1", "8080:80", "http://localhost:8080/", "1", "100", "web", "0", "", "2m"}
+		inactive = resource.Row{"default", "app=web", "c1", "8080:80", "", "1", "100", "web", "0", "", "n/a"}
+	)
+
+	uu := colorerUCs{
+		{"", &resource.RowEvent{Action: resource.New, Fields: active}, tcell.ColorSkyblue},
+		{"", &resource.RowEvent{Action: resource.New, Fields: inactive}, tcell.ColorGray},
+	}
+
+	for _, u := range uu {
+		assert.Equal(t, u.e, forwardColorer(u.ns, u.r))
+	}
+}
@@ -65,6 +65,31 @@ func (c *command) isK9sCmd(cmd string) bool {
 	case "alias":
 		c.app.aliasCmd(nil)
 		return true
+	case "pulse", "pu":
+		ns := c.app.Config.ActiveNamespace()
+		if len(cmds) == 2 {
+			ns = cmds[1]
+		}
+		c.app.inject(newPulseView(c.app, ns))
+		return true
+	case "search":
+		if len(cmds) < 2 || cmds[1] == "" {
+			c.app.Flash().Warn("Usage: search <term> [namespace]")
+			return true
+		}
+		ns := c.app.Config.ActiveNamespace()
+		if len(cmds) == 3 {
+			ns = cmds[2]
+		}
+		c.app.inject(newSearchView(c.app, ns, cmds[1]))
+		return true
+	case "xray":
+		ns := c.app.Config.ActiveNamespace()
+		if len(cmds) == 2 {
+			ns = cmds[1]
+		}
+		c.app.inject(newXRayView(c.app, ns, "", ""))
+		return true
 	default:
 		if !authRX.MatchString(cmd) {
 			return false
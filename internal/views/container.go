@@ -12,7 +12,6 @@ import (
 	"github.com/derailed/k9s/internal/ui/dialog"
 	"github.com/gdamore/tcell"
 	"github.com/rs/zerolog/log"
-	"k8s.io/client-go/tools/portforward"
 )
 
 type containerView struct {
@@ -96,7 +95,7 @@ func (v *containerView) portFwdCmd(evt *tcell.EventKey) *tcell.EventKey {
 	}
 
 	sel := v.masterPage().GetSelectedItem()
-	if _, ok := v.app.forwarders[fwFQN(*v.path, sel)]; ok {
+	if _, _, ok := v.app.findForwarder(fwFQN(*v.path, sel)); ok {
 		v.app.Flash().Err(fmt.Errorf("A PortForward already exist on container %s", *v.path))
 		return nil
 	}
@@ -143,25 +142,9 @@ func (v *containerView) portForward(lport, cport string) {
 	}
 
 	log.Debug().Msgf(">>> Starting port forward %q %v", *v.path, ports)
-	go v.runForward(pf, fw)
-}
-
-func (v *containerView) runForward(pf *k8s.PortForward, f *portforward.PortForwarder) {
-	v.app.QueueUpdateDraw(func() {
-		v.app.forwarders[pf.FQN()] = pf
-		v.app.Flash().Infof("PortForward activated %s:%s", pf.Path(), pf.Ports()[0])
+	go v.app.runForward(pf, fw, func() {
 		dialog.DismissPortForward(v.Pages)
 	})
-
-	pf.SetActive(true)
-	if err := f.ForwardPorts(); err != nil {
-		v.app.Flash().Err(err)
-		return
-	}
-	v.app.QueueUpdateDraw(func() {
-		delete(v.app.forwarders, pf.FQN())
-		pf.SetActive(false)
-	})
 }
 
 func (v *containerView) backCmd(evt *tcell.EventKey) *tcell.EventKey {
@@ -1,9 +1,17 @@
 package views
 
 import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/derailed/k9s/internal/k8s"
 	"github.com/derailed/k9s/internal/resource"
 	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/k9s/internal/ui/dialog"
 	"github.com/gdamore/tcell"
+	batchv1beta1 "k8s.io/api/batch/v1beta1"
+	"k8s.io/apimachinery/pkg/types"
 )
 
 type cronJobView struct {
@@ -13,6 +21,7 @@ type cronJobView struct {
 func newCronJobView(title, gvr string, app *appView, list resource.List) resourceViewer {
 	v := cronJobView{resourceView: newResourceView(title, gvr, app, list).(*resourceView)}
 	v.extraActionsFn = v.extraActions
+	v.enterFn = v.showJobs
 
 	return &v
 }
@@ -32,6 +41,76 @@ func (v *cronJobView) trigger(evt *tcell.EventKey) *tcell.EventKey {
 	return nil
 }
 
+func (v *cronJobView) suspendCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if !v.masterPage().RowSelected() {
+		return evt
+	}
+
+	sel := v.masterPage().GetSelectedItem()
+	r, ok := v.list.Resource().(resource.Suspendable)
+	if !ok {
+		v.app.Flash().Err(errors.New("resource is not of type resource.Suspendable"))
+		return nil
+	}
+	ns, n := namespaced(sel)
+	suspended, err := r.IsSuspended(ns, n)
+	if err != nil {
+		v.app.Flash().Err(err)
+		return nil
+	}
+
+	op, fn := "Suspend", r.Suspend
+	if suspended {
+		op, fn = "Resume", r.Resume
+	}
+	msg := fmt.Sprintf("%s cronjob %s?", op, sel)
+	dialog.ShowConfirm(v.Pages, "<Confirm "+op+">", msg, func() {
+		if err := fn(ns, n); err != nil {
+			v.app.Flash().Err(err)
+			return
+		}
+		v.app.Flash().Infof("Cronjob %s %sd", sel, strings.ToLower(op))
+		v.refresh()
+	}, func() {
+		v.showMaster()
+	})
+
+	return nil
+}
+
 func (v *cronJobView) extraActions(aa ui.KeyActions) {
 	aa[tcell.KeyCtrlT] = ui.NewKeyAction("Trigger", v.trigger, true)
+	aa[ui.KeyZ] = ui.NewKeyAction("Suspend/Resume", v.suspendCmd, true)
+	aa[ui.KeyI] = ui.NewKeyAction("Set Image", v.setImageCmd, true)
+}
+
+func (v *cronJobView) showJobs(app *appView, _, res, sel string) {
+	ns, n := namespaced(sel)
+	cj, err := k8s.NewCronJob(app.Conn()).Get(ns, n)
+	if err != nil {
+		app.Flash().Err(err)
+		return
+	}
+
+	showJobs(app, ns, cj.(*batchv1beta1.CronJob).UID, v.backCmd)
+}
+
+func (v *cronJobView) backCmd(evt *tcell.EventKey) *tcell.EventKey {
+	v.app.inject(v)
+
+	return nil
+}
+
+func showJobs(app *appView, ns string, owner types.UID, a ui.ActionHandler) {
+	app.switchNS(ns)
+
+	list := resource.NewOwnedJobList(app.Conn(), ns, owner)
+	jv := newJobView("Job", "batch/v1/jobs", app, list)
+	jv.setColorerFn(jobColorer)
+	jv.masterPage().SetActions(ui.KeyActions{
+		tcell.KeyEsc: ui.NewKeyAction("Back", a, true),
+	})
+	// Reset active namespace to ns.
+	app.Config.SetActiveNamespace(ns)
+	app.inject(jv)
 }
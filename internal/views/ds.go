@@ -28,6 +28,8 @@ func newDaemonSetView(title, gvr string, app *appView, list resource.List) resou
 func (v *daemonSetView) extraActions(aa ui.KeyActions) {
 	v.logResourceView.extraActions(aa)
 	v.restartableResourceView.extraActions(aa)
+	aa[ui.KeyI] = ui.NewKeyAction("Set Image", v.setImageCmd, true)
+	aa[ui.KeyX] = ui.NewKeyAction("XRay", xrayCmd(v.resourceView, k8s.XRayDaemonSet), true)
 	aa[ui.KeyShiftD] = ui.NewKeyAction("Sort Desired", v.sortColCmd(1, false), false)
 	aa[ui.KeyShiftC] = ui.NewKeyAction("Sort Current", v.sortColCmd(2, false), false)
 }
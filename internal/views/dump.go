@@ -7,6 +7,7 @@ import (
 	"io/ioutil"
 	"os"
 	"path/filepath"
+	"sort"
 	"time"
 
 	"github.com/derailed/k9s/internal/config"
@@ -89,6 +90,8 @@ func (v *dumpView) registerActions() {
 		tcell.KeyEnter: ui.NewKeyAction("Enter", v.enterCmd, true),
 		tcell.KeyCtrlD: ui.NewKeyAction("Delete", v.deleteCmd, true),
 		tcell.KeyCtrlS: ui.NewKeyAction("Save", noopCmd, false),
+		ui.KeySpace:    ui.NewKeyAction("Mark", v.markCmd, true),
+		ui.KeyC:        ui.NewKeyAction("Compare", v.compareCmd, true),
 	}
 
 	tv := v.getTV()
@@ -128,6 +131,55 @@ func (v *dumpView) enterCmd(evt *tcell.EventKey) *tcell.EventKey {
 	return nil
 }
 
+func (v *dumpView) markCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if !v.getTV().RowSelected() {
+		return evt
+	}
+
+	v.getTV().ToggleMark()
+	v.refresh()
+
+	return nil
+}
+
+func (v *dumpView) compareCmd(evt *tcell.EventKey) *tcell.EventKey {
+	ff := v.getTV().GetSelectedItems()
+	if len(ff) != 2 {
+		v.app.Flash().Warn("Mark two screen dumps to compare")
+		return nil
+	}
+	if dumpResource(ff[0]) != dumpResource(ff[1]) {
+		v.app.Flash().Warnf("Unable to compare %s with %s dumps", dumpResource(ff[0]), dumpResource(ff[1]))
+		return nil
+	}
+
+	dir := filepath.Join(config.K9sDumpDir, v.app.Config.K9s.CurrentCluster)
+	sort.Slice(ff, func(i, j int) bool {
+		return modTime(filepath.Join(dir, ff[i])).Before(modTime(filepath.Join(dir, ff[j])))
+	})
+	o, err := loadDump(filepath.Join(dir, ff[0]))
+	if err != nil {
+		v.app.Flash().Err(err)
+		return nil
+	}
+	n, err := loadDump(filepath.Join(dir, ff[1]))
+	if err != nil {
+		v.app.Flash().Err(err)
+		return nil
+	}
+
+	details := v.getDetails()
+	details.setCategory("Compare")
+	details.setTitle(ff[0] + " -> " + ff[1])
+	details.SetTextColor(v.app.Styles.FgColor())
+	details.SetText(renderDiff(diffDumps(o, n), v.app.Styles.Frame().Status))
+	details.ScrollToBeginning()
+	v.SwitchToPage("details")
+	v.app.SetHints(details.hints())
+
+	return nil
+}
+
 func (v *dumpView) deleteCmd(evt *tcell.EventKey) *tcell.EventKey {
 	sel := v.getTV().GetSelectedItem()
 	if sel == "" {
@@ -152,6 +204,8 @@ func (v *dumpView) backCmd(evt *tcell.EventKey) *tcell.EventKey {
 		v.cancel()
 	}
 	v.SwitchToPage("table")
+	v.app.SetHints(v.getTV().Hints())
+
 	return nil
 }
 
@@ -230,6 +284,15 @@ func (v *dumpView) getDetails() *detailsView {
 	return nil
 }
 
+func modTime(path string) time.Time {
+	fi, err := os.Stat(path)
+	if err != nil {
+		return time.Time{}
+	}
+
+	return fi.ModTime()
+}
+
 func noopCmd(*tcell.EventKey) *tcell.EventKey {
 	return nil
 }
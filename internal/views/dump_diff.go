@@ -0,0 +1,276 @@
+package views
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/resource"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tview"
+	"sigs.k8s.io/yaml"
+)
+
+// Columns that always change between dumps and are not worth reporting.
+var volatileCols = map[string]bool{"AGE": true}
+
+type (
+	// DumpTable represents a screen dump keyed by row name.
+	dumpTable struct {
+		header resource.Row
+		rows   map[string]resource.Row
+	}
+
+	// DumpDiff tracks the differences between two screen dumps.
+	dumpDiff struct {
+		added, deleted []string
+		changed        []rowDiff
+		same           int
+	}
+
+	// RowDiff tracks a changed row.
+	rowDiff struct {
+		key  string
+		cols []colDiff
+	}
+
+	// ColDiff tracks a changed column value.
+	colDiff struct {
+		name, o, n string
+	}
+)
+
+// LoadDump reads a screen dump from disk.
+func loadDump(path string) (dumpTable, error) {
+	raw, err := ioutil.ReadFile(path)
+	if err != nil {
+		return dumpTable{}, err
+	}
+	hh, rr, err := parseDump(filepath.Ext(path), raw)
+	if err != nil {
+		return dumpTable{}, fmt.Errorf("Unable to read dump %s: %s", filepath.Base(path), err)
+	}
+
+	return newDumpTable(hh, rr), nil
+}
+
+// DumpResource returns the resource name of a screen dump file. Resource names
+// may contain dashes, so dumps are named resource_ns_time. Older dumps used dashes.
+func dumpResource(f string) string {
+	base := filepath.Base(f)
+	if i := strings.Index(base, "_"); i >= 0 {
+		return base[:i]
+	}
+
+	return strings.Split(base, "-")[0]
+}
+
+func parseDump(ext string, raw []byte) (resource.Row, []resource.Row, error) {
+	switch ext {
+	case ".csv":
+		rr, err := csv.NewReader(bytes.NewReader(raw)).ReadAll()
+		if err != nil || len(rr) == 0 {
+			return nil, nil, err
+		}
+		return toRow(rr[0]), toRows(rr[1:]), nil
+	case ".md":
+		return parseMarkdown(raw)
+	case ".json", ".yaml":
+		var recs []map[string]string
+		var err error
+		if ext == ".json" {
+			err = json.Unmarshal(raw, &recs)
+		} else {
+			err = yaml.Unmarshal(raw, &recs)
+		}
+		if err != nil {
+			return nil, nil, fmt.Errorf("not a table export (%s)", err)
+		}
+		return fromRecords(recs)
+	default:
+		return nil, nil, fmt.Errorf("unsupported format %q", ext)
+	}
+}
+
+func parseMarkdown(raw []byte) (resource.Row, []resource.Row, error) {
+	var rr []resource.Row
+	for i, l := range strings.Split(strings.TrimSpace(string(raw)), "\n") {
+		if i == 1 {
+			continue
+		}
+		l = strings.TrimSpace(l)
+		l = strings.TrimSuffix(strings.TrimPrefix(l, "| "), " |")
+		cc := strings.Split(l, " | ")
+		for j := range cc {
+			cc[j] = strings.Replace(cc[j], `\|`, "|", -1)
+		}
+		rr = append(rr, cc)
+	}
+	if len(rr) == 0 {
+		return nil, nil, nil
+	}
+
+	return rr[0], rr[1:], nil
+}
+
+func fromRecords(recs []map[string]string) (resource.Row, []resource.Row, error) {
+	cols := make(map[string]bool)
+	for _, r := range recs {
+		for k := range r {
+			cols[k] = true
+		}
+	}
+	var hh resource.Row
+	for _, c := range []string{"NAMESPACE", "NAME"} {
+		if cols[c] {
+			hh = append(hh, c)
+			delete(cols, c)
+		}
+	}
+	rest := make([]string, 0, len(cols))
+	for c := range cols {
+		rest = append(rest, c)
+	}
+	sort.Strings(rest)
+	hh = append(hh, rest...)
+
+	rr := make([]resource.Row, 0, len(recs))
+	for _, rec := range recs {
+		r := make(resource.Row, len(hh))
+		for i, h := range hh {
+			r[i] = rec[h]
+		}
+		rr = append(rr, r)
+	}
+
+	return hh, rr, nil
+}
+
+func newDumpTable(hh resource.Row, rr []resource.Row) dumpTable {
+	t := dumpTable{header: hh, rows: make(map[string]resource.Row, len(rr))}
+	ns, n := t.col("NAMESPACE"), t.col("NAME")
+	if n < 0 {
+		n = 0
+	}
+	for _, r := range rr {
+		if n >= len(r) {
+			continue
+		}
+		k := r[n]
+		if ns >= 0 && ns < len(r) {
+			k = fqn(r[ns], k)
+		}
+		t.rows[k] = r
+	}
+
+	return t
+}
+
+func (t dumpTable) col(name string) int {
+	for i, h := range t.header {
+		if h == name {
+			return i
+		}
+	}
+
+	return -1
+}
+
+func (t dumpTable) field(r resource.Row, name string) string {
+	i := t.col(name)
+	if i < 0 || i >= len(r) {
+		return ""
+	}
+
+	return r[i]
+}
+
+// DiffDumps matches rows by name and reports added, deleted and changed rows.
+func diffDumps(o, n dumpTable) dumpDiff {
+	var d dumpDiff
+	for k := range n.rows {
+		if _, ok := o.rows[k]; !ok {
+			d.added = append(d.added, k)
+		}
+	}
+	for k, or := range o.rows {
+		nr, ok := n.rows[k]
+		if !ok {
+			d.deleted = append(d.deleted, k)
+			continue
+		}
+		var cc []colDiff
+		for _, h := range n.header {
+			if volatileCols[h] || o.col(h) < 0 {
+				continue
+			}
+			if ov, nv := o.field(or, h), n.field(nr, h); ov != nv {
+				cc = append(cc, colDiff{name: h, o: ov, n: nv})
+			}
+		}
+		if len(cc) == 0 {
+			d.same++
+			continue
+		}
+		d.changed = append(d.changed, rowDiff{key: k, cols: cc})
+	}
+	sort.Strings(d.added)
+	sort.Strings(d.deleted)
+	sort.Slice(d.changed, func(i, j int) bool {
+		return d.changed[i].key < d.changed[j].key
+	})
+
+	return d
+}
+
+// RenderDiff renders a dump diff using the table deltas markers.
+func renderDiff(d dumpDiff, st config.Status) string {
+	buff := new(bytes.Buffer)
+	fmt.Fprintf(buff, "[%s::b]%d added, %d deleted, %d changed, %d unchanged[-::-]\n",
+		st.HighlightColor, len(d.added), len(d.deleted), len(d.changed), d.same)
+
+	for _, k := range d.added {
+		fmt.Fprintf(buff, "\n[%s::b]+ %s[-::-]", st.AddColor, tview.Escape(k))
+	}
+	for _, k := range d.deleted {
+		fmt.Fprintf(buff, "\n[%s::b]- %s[-::-]", st.KillColor, tview.Escape(k))
+	}
+	for _, r := range d.changed {
+		fmt.Fprintf(buff, "\n[%s::b]%s %s[-::-]", st.ModifyColor, ui.DeltaSign, tview.Escape(r.key))
+		for _, c := range r.cols {
+			fmt.Fprintf(buff, "\n    %-15s %s -> %s%s", tview.Escape(c.name), tview.Escape(asValue(c.o)), tview.Escape(asValue(c.n)), ui.Deltas(c.o, c.n))
+		}
+	}
+
+	return buff.String()
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func asValue(s string) string {
+	if s == "" {
+		return `""`
+	}
+
+	return s
+}
+
+func toRow(ss []string) resource.Row {
+	return resource.Row(ss)
+}
+
+func toRows(sss [][]string) []resource.Row {
+	rr := make([]resource.Row, 0, len(sss))
+	for _, ss := range sss {
+		rr = append(rr, toRow(ss))
+	}
+
+	return rr
+}
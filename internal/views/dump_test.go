@@ -0,0 +1,109 @@
+package views
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseDump(t *testing.T) {
+	e := resource.Row{"NAMESPACE", "NAME", "STATUS"}
+	uu := map[string]struct {
+		ext, raw string
+		err      bool
+	}{
+		"csv":      {ext: ".csv", raw: "NAMESPACE,NAME,STATUS\nns1,a,Running\n"},
+		"markdown": {ext: ".md", raw: "| NAMESPACE | NAME | STATUS |\n| --- | --- | --- |\n| ns1 | a | Running |\n"},
+		"json":     {ext: ".json", raw: `[{"NAME": "a", "STATUS": "Running", "NAMESPACE": "ns1"}]`},
+		"yaml":     {ext: ".yaml", raw: "- NAME: a\n  NAMESPACE: ns1\n  STATUS: Running\n"},
+		"fullList": {ext: ".json", raw: `{"kind": "List", "items": []}`, err: true},
+		"unknown":  {ext: ".txt", raw: "blee", err: true},
+	}
+
+	for k, u := range uu {
+		t.Run(k, func(t *testing.T) {
+			hh, rr, err := parseDump(u.ext, []byte(u.raw))
+			if u.err {
+				assert.NotNil(t, err)
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, e, hh)
+			assert.Equal(t, []resource.Row{{"ns1", "a", "Running"}}, rr)
+		})
+	}
+}
+
+func TestDiffDumps(t *testing.T) {
+	hh := resource.Row{"NAMESPACE", "NAME", "STATUS", "RESTARTS", "AGE"}
+	o := newDumpTable(hh, []resource.Row{
+		{"ns1", "a", "Running", "0", "1h"},
+		{"ns1", "b", "Running", "0", "1h"},
+		{"ns2", "a", "Running", "1", "1h"},
+	})
+	n := newDumpTable(hh, []resource.Row{
+		{"ns1", "a", "Running", "0", "2h"},
+		{"ns2", "a", "CrashLoopBackOff", "5", "2h"},
+		{"ns2", "c", "Pending", "0", "1m"},
+	})
+
+	d := diffDumps(o, n)
+	assert.Equal(t, []string{"ns2/c"}, d.added)
+	assert.Equal(t, []string{"ns1/b"}, d.deleted)
+	assert.Equal(t, 1, d.same)
+	assert.Equal(t, []rowDiff{
+		{key: "ns2/a", cols: []colDiff{
+			{name: "STATUS", o: "Running", n: "CrashLoopBackOff"},
+			{name: "RESTARTS", o: "1", n: "5"},
+		}},
+	}, d.changed)
+
+	st := config.Status{HighlightColor: "aqua", AddColor: "blue", KillColor: "gray", ModifyColor: "green"}
+	lines := strings.Split(renderDiff(d, st), "\n")
+	assert.Equal(t, "[aqua::b]1 added, 1 deleted, 1 changed, 1 unchanged[-::-]", lines[0])
+	assert.Equal(t, "[blue::b]+ ns2/c[-::-]", lines[2])
+	assert.Equal(t, "[gray::b]- ns1/b[-::-]", lines[3])
+	assert.Equal(t, "[green::b]Δ ns2/a[-::-]", lines[4])
+	assert.Equal(t, "    STATUS          Running -> CrashLoopBackOffΔ", lines[5])
+	assert.Equal(t, "    RESTARTS        1 -> 5↑", lines[6])
+}
+
+func TestRenderDiffEscape(t *testing.T) {
+	d := dumpDiff{
+		added:   []string{"ns1/[blee]"},
+		changed: []rowDiff{{key: "ns1/a", cols: []colDiff{{name: "LABELS", o: "[red]", n: "[fred]"}}}},
+	}
+
+	st := config.Status{HighlightColor: "aqua", AddColor: "blue", KillColor: "gray", ModifyColor: "green"}
+	lines := strings.Split(renderDiff(d, st), "\n")
+	assert.Equal(t, "[blue::b]+ ns1/[blee[][-::-]", lines[2])
+	assert.Equal(t, "    LABELS          [red[] -> [fred[]Δ", lines[4])
+}
+
+func TestDiffDumpsNoNamespace(t *testing.T) {
+	o := newDumpTable(resource.Row{"NAME", "STATUS"}, []resource.Row{{"n1", "Ready"}})
+	n := newDumpTable(resource.Row{"NAME", "ROLES", "STATUS"}, []resource.Row{{"n1", "master", "NotReady"}})
+
+	d := diffDumps(o, n)
+	assert.Equal(t, []rowDiff{{key: "n1", cols: []colDiff{{name: "STATUS", o: "Ready", n: "NotReady"}}}}, d.changed)
+}
+
+func TestDumpResource(t *testing.T) {
+	uu := map[string]struct {
+		f, e string
+	}{
+		"namespaced": {"/tmp/pods_kube-system_1570000000.csv", "pods"},
+		"cluster":    {"nodes_1570000000.md", "nodes"},
+		"dashed":     {"pod-disruption-budgets_all_1570000000.csv", "pod-disruption-budgets"},
+		"legacy":     {"/tmp/pods-all-1570000000.csv", "pods"},
+	}
+
+	for k, u := range uu {
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, dumpResource(u.f))
+		})
+	}
+}
@@ -21,6 +21,9 @@ const (
 	forwardTitle    = "Port Forwards"
 	forwardTitleFmt = " [aqua::b]%s([fuchsia::b]%d[fuchsia::-])[aqua::-] "
 	promptPage      = "prompt"
+	inactiveAge     = "n/a"
+	profileCol      = 7
+	ageCol          = 10
 )
 
 type forwardView struct {
@@ -68,7 +71,8 @@ func (v *forwardView) Init(ctx context.Context, _ string) {
 
 	tv := v.getTV()
 	v.refresh()
-	tv.SetSortCol(tv.NameColIndex()+6, 0, true)
+	go v.updater(ctx)
+	tv.SetSortCol(ageCol, 0, true)
 	tv.Refresh()
 	tv.Select(1, 0)
 	v.app.SetFocus(tv)
@@ -91,6 +95,22 @@ func (v *forwardView) reload() {
 	v.refresh()
 }
 
+func (v *forwardView) updater(ctx context.Context) {
+	rate := time.Duration(v.app.Config.K9s.GetRefreshRate()) * time.Second
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(rate):
+			v.app.QueueUpdateDraw(func() {
+				tv := v.getTV()
+				tv.Update(v.hydrate())
+				tv.UpdateTitle()
+			})
+		}
+	}
+}
+
 func (v *forwardView) refresh() {
 	tv := v.getTV()
 	tv.Update(v.hydrate())
@@ -105,6 +125,8 @@ func (v *forwardView) registerActions() {
 		tcell.KeyCtrlB: ui.NewKeyAction("Bench", v.benchCmd, true),
 		tcell.KeyCtrlK: ui.NewKeyAction("Bench Stop", v.benchStopCmd, true),
 		tcell.KeyCtrlD: ui.NewKeyAction("Delete", v.deleteCmd, true),
+		ui.KeyS:        ui.NewKeyAction("Start", v.startCmd, true),
+		ui.KeyX:        ui.NewKeyAction("Stop", v.stopCmd, true),
 		ui.KeySlash:    ui.NewKeyAction("Filter", tv.activateCmd, false),
 		ui.KeyP:        ui.NewKeyAction("Previous", v.app.prevCmd, false),
 		ui.KeyShiftP:   ui.NewKeyAction("Sort Ports", v.sortColCmd(2, true), false),
@@ -153,6 +175,10 @@ func (v *forwardView) benchCmd(evt *tcell.EventKey) *tcell.EventKey {
 		v.app.Flash().Err(errors.New("Only one benchmark allowed at a time"))
 		return nil
 	}
+	if _, _, ok := v.app.findForwarder(sel); !ok {
+		v.app.Flash().Errf("PortForward %s is not active", sel)
+		return nil
+	}
 
 	tv := v.getTV()
 	r, _ := tv.GetSelection()
@@ -183,6 +209,9 @@ func (v *forwardView) runBenchmark() {
 		v.app.QueueUpdate(func() {
 			if v.bench.Canceled() {
 				v.app.status(ui.FlashInfo, "Benchmark canceled")
+			} else if v.bench.StoppedEarly() {
+				v.app.status(ui.FlashWarn, "Benchmark ran out of requests before its duration!")
+				v.bench.Cancel()
 			} else {
 				v.app.status(ui.FlashInfo, "Benchmark Completed!")
 				v.bench.Cancel()
@@ -208,6 +237,55 @@ func (v *forwardView) getSelectedItem() string {
 	)
 }
 
+func (v *forwardView) getSelectedProfile() string {
+	tv := v.getTV()
+	r, _ := tv.GetSelection()
+	if r == 0 {
+		return ""
+	}
+
+	return ui.TrimCell(tv.Table, r, profileCol)
+}
+
+func (v *forwardView) startCmd(evt *tcell.EventKey) *tcell.EventKey {
+	n := v.getSelectedProfile()
+	if n == "" {
+		return evt
+	}
+
+	p, ok := v.app.portForward(n)
+	if !ok {
+		v.app.Flash().Errf("Unable to find port forward definition %s", n)
+		return nil
+	}
+	if err := v.app.startProfile(p, v.refresh); err != nil {
+		v.app.Flash().Err(err)
+		return nil
+	}
+	v.app.Flash().Infof("Starting PortForward %s...", n)
+
+	return nil
+}
+
+func (v *forwardView) stopCmd(evt *tcell.EventKey) *tcell.EventKey {
+	n := v.getSelectedProfile()
+	if n == "" {
+		return evt
+	}
+
+	k, fw, ok := v.app.profileForwarder(n)
+	if !ok {
+		v.app.Flash().Warnf("PortForward %s is not active", n)
+		return nil
+	}
+	fw.Stop()
+	delete(v.app.forwarders, k)
+	v.refresh()
+	v.app.Flash().Infof("PortForward %s stopped!", n)
+
+	return nil
+}
+
 func (v *forwardView) deleteCmd(evt *tcell.EventKey) *tcell.EventKey {
 	tv := v.getTV()
 	if !tv.SearchBuff().Empty() {
@@ -221,13 +299,13 @@ func (v *forwardView) deleteCmd(evt *tcell.EventKey) *tcell.EventKey {
 	}
 
 	showModal(v.Pages, fmt.Sprintf("Delete PortForward `%s?", sel), "table", func() {
-		fw, ok := v.app.forwarders[sel]
+		k, fw, ok := v.app.findForwarder(sel)
 		if !ok {
 			log.Debug().Msgf("Unable to find forwarder %s", sel)
 			return
 		}
 		fw.Stop()
-		delete(v.app.forwarders, sel)
+		delete(v.app.forwarders, k)
 
 		log.Debug().Msgf("PortForwards after delete: %#v", v.app.forwarders)
 		v.getTV().Update(v.hydrate())
@@ -257,8 +335,32 @@ func (v *forwardView) hints() ui.Hints {
 }
 
 func (v *forwardView) hydrate() resource.TableData {
-	data := initHeader(len(v.app.forwarders))
+	pp := v.app.portForwards()
+	data := initHeader(len(v.app.forwarders) + len(pp))
 	dc, dn := v.app.Bench.Benchmarks.Defaults.C, v.app.Bench.Benchmarks.Defaults.N
+	for _, p := range pp {
+		if _, _, ok := v.app.profileForwarder(p.Name); ok {
+			continue
+		}
+		fields := resource.Row{
+			p.Namespace,
+			p.Selector,
+			p.Container,
+			strings.Join(p.Ports, ","),
+			"",
+			asNum(dc),
+			asNum(dn),
+			p.Name,
+			asNum(0),
+			"",
+			inactiveAge,
+		}
+		data.Rows[profileKey(p.Name)] = &resource.RowEvent{
+			Action: resource.New,
+			Fields: fields,
+			Deltas: fields,
+		}
+	}
 	for _, f := range v.app.forwarders {
 		c, n, cfg := loadConfig(dc, dn, containerID(f.Path(), f.Container()), v.app.Bench.Benchmarks.Containers)
 
@@ -272,6 +374,9 @@ func (v *forwardView) hydrate() resource.TableData {
 			urlFor(cfg, f.Container(), ports[0]),
 			asNum(c),
 			asNum(n),
+			f.Profile(),
+			asNum(f.Reconnects()),
+			f.LastError(),
 			f.Age(),
 		}
 		data.Rows[f.Path()] = &resource.RowEvent{
@@ -304,13 +409,17 @@ func defaultConfig() config.BenchConfig {
 
 func initHeader(rows int) resource.TableData {
 	return resource.TableData{
-		Header:    resource.Row{"NAMESPACE", "NAME", "CONTAINER", "PORTS", "URL", "C", "N", "AGE"},
-		NumCols:   map[string]bool{"C": true, "N": true},
+		Header:    resource.Row{"NAMESPACE", "NAME", "CONTAINER", "PORTS", "URL", "C", "N", "PROFILE", "RECONNECTS", "LAST ERROR", "AGE"},
+		NumCols:   map[string]bool{"C": true, "N": true, "RECONNECTS": true},
 		Rows:      make(resource.RowEvents, rows),
 		Namespace: resource.AllNamespaces,
 	}
 }
 
+func profileKey(n string) string {
+	return "profile:" + n
+}
+
 func loadConfig(dc, dn int, id string, cc map[string]config.BenchConfig) (int, int, config.BenchConfig) {
 	c, n := dc, dn
 	cfg, ok := cc[id]
@@ -0,0 +1,132 @@
+package views
+
+import (
+	"fmt"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/k8s"
+	"github.com/rs/zerolog/log"
+	"k8s.io/client-go/tools/portforward"
+)
+
+// PortForwards returns the port forward definitions for the current context.
+func (a *appView) portForwards() []*config.PortForward {
+	cl := a.Config.CurrentCluster()
+	if cl == nil {
+		return nil
+	}
+
+	return cl.PortForwardsFor(a.Config.K9s.CurrentContext)
+}
+
+// PortForward returns a port forward definition by name.
+func (a *appView) portForward(name string) (*config.PortForward, bool) {
+	for _, p := range a.portForwards() {
+		if p.Name == name {
+			return p, true
+		}
+	}
+
+	return nil, false
+}
+
+// ProfileForwarder returns the active forwarder for a given definition.
+func (a *appView) profileForwarder(name string) (string, forwarder, bool) {
+	for k, f := range a.forwarders {
+		if f.Profile() == name {
+			return k, f, true
+		}
+	}
+
+	return "", nil, false
+}
+
+// FindForwarder returns the forwarder currently bound to the given container.
+func (a *appView) findForwarder(sel string) (string, forwarder, bool) {
+	for k, f := range a.forwarders {
+		if fwFQN(f.Path(), f.Container()) == sel {
+			return k, f, true
+		}
+	}
+
+	return "", nil, false
+}
+
+func (a *appView) autoStartForwards() {
+	for _, p := range a.portForwards() {
+		if !p.AutoStart {
+			continue
+		}
+		if err := a.startProfile(p, nil); err != nil {
+			log.Error().Err(err).Msgf("Unable to auto start port forward %s", p.Name)
+		}
+	}
+}
+
+func (a *appView) startProfile(p *config.PortForward, readyFn func()) error {
+	if _, _, ok := a.profileForwarder(p.Name); ok || a.starting[p.Name] {
+		return fmt.Errorf("port forward %s is already active", p.Name)
+	}
+	// Forwarders are registered asynchronously, flag the profile right away.
+	a.starting[p.Name] = true
+	if err := a.launchProfile(p, readyFn); err != nil {
+		delete(a.starting, p.Name)
+		return err
+	}
+
+	return nil
+}
+
+func (a *appView) launchProfile(p *config.PortForward, readyFn func()) error {
+	po, err := k8s.FindReadyPod(a.Conn(), p.Namespace, p.Selector)
+	if err != nil {
+		return err
+	}
+	ports, err := k8s.TargetPorts(nil, *po, p.Ports)
+	if err != nil {
+		return err
+	}
+	co := p.Container
+	if co == "" {
+		co = k8s.PortContainer(*po, ports[0])
+	}
+	path := fqn(po.Namespace, po.Name)
+	if _, _, ok := a.findForwarder(fwFQN(path, co)); ok {
+		return fmt.Errorf("a port forward already exist on container %s", fwFQN(path, co))
+	}
+
+	pf := k8s.NewPortForward(a.Conn(), &log.Logger)
+	pf.SetProfile(p.Name)
+	pf.SetSelector(p.Selector)
+	fw, err := pf.Start(path, co, ports)
+	if err != nil {
+		return err
+	}
+
+	log.Debug().Msgf(">>> Starting port forward %s %q %v", p.Name, path, ports)
+	go a.runForward(pf, fw, readyFn)
+
+	return nil
+}
+
+func (a *appView) runForward(pf *k8s.PortForward, f *portforward.PortForwarder, readyFn func()) {
+	key := pf.FQN()
+	a.QueueUpdateDraw(func() {
+		a.forwarders[key] = pf
+		delete(a.starting, pf.Profile())
+		a.Flash().Infof("PortForward activated %s:%s", pf.Path(), pf.Ports()[0])
+		if readyFn != nil {
+			readyFn()
+		}
+	})
+
+	err := pf.Run(f)
+	a.QueueUpdateDraw(func() {
+		if err != nil {
+			a.Flash().Err(err)
+		}
+		if fw, ok := a.forwarders[key]; ok && fw == forwarder(pf) {
+			delete(a.forwarders, key)
+		}
+	})
+}
@@ -0,0 +1,90 @@
+package views
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/derailed/k9s/internal/k8s"
+	"github.com/derailed/k9s/internal/ui/dialog"
+	"github.com/derailed/tview"
+	"github.com/rs/zerolog/log"
+	v1 "k8s.io/api/core/v1"
+)
+
+// FwTarget tracks a workload port forward destination.
+type fwTarget struct {
+	ns, selector string
+	svc          *v1.Service
+}
+
+// ShowForward resolves a workload to a ready pod and pops the port forward dialog.
+func showForward(app *appView, pages *tview.Pages, t fwTarget) {
+	if t.selector == "" {
+		app.Flash().Err(errors.New("No selector found on this resource"))
+		return
+	}
+
+	po, err := k8s.FindReadyPod(app.Conn(), t.ns, t.selector)
+	if err != nil {
+		app.Flash().Err(err)
+		return
+	}
+
+	port := t.defaultPort(*po)
+	if port == "" {
+		app.Flash().Warn("No valid TCP port found on this resource. User will specify...")
+		port = "MY_TCP_PORT!"
+	}
+	dialog.ShowPortForward(pages, port, func(lport, cport string) {
+		startForward(app, pages, t, *po, lport, cport)
+	})
+}
+
+func startForward(app *appView, pages *tview.Pages, t fwTarget, po v1.Pod, lport, cport string) {
+	ports, err := k8s.TargetPorts(t.svc, po, []string{lport + ":" + cport})
+	if err != nil {
+		app.Flash().Err(err)
+		return
+	}
+
+	path, co := fqn(po.Namespace, po.Name), k8s.PortContainer(po, ports[0])
+	if _, _, ok := app.findForwarder(fwFQN(path, co)); ok {
+		app.Flash().Err(fmt.Errorf("A PortForward already exist on container %s", fwFQN(path, co)))
+		return
+	}
+
+	pf := k8s.NewPortForward(app.Conn(), &log.Logger)
+	pf.SetSelector(t.selector)
+	fw, err := pf.Start(path, co, ports)
+	if err != nil {
+		app.Flash().Err(err)
+		return
+	}
+
+	log.Debug().Msgf(">>> Starting port forward %q %v", path, ports)
+	go app.runForward(pf, fw, func() {
+		dialog.DismissPortForward(pages)
+	})
+}
+
+func (t fwTarget) defaultPort(po v1.Pod) string {
+	if t.svc != nil {
+		for _, p := range t.svc.Spec.Ports {
+			if p.Protocol == v1.ProtocolTCP || p.Protocol == "" {
+				return strconv.Itoa(int(p.Port))
+			}
+		}
+		return ""
+	}
+
+	for _, co := range po.Spec.Containers {
+		for _, p := range co.Ports {
+			if p.Protocol == v1.ProtocolTCP || p.Protocol == "" {
+				return strconv.Itoa(int(p.ContainerPort))
+			}
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,195 @@
+package views
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/derailed/k9s/internal/k8s"
+	"github.com/derailed/k9s/internal/resource"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tview"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	historyPage      = "history"
+	revisionDiffPage = "revisionDiff"
+	currentRevision  = "✓"
+)
+
+var historyHeader = resource.Row{"REVISION", "CURRENT", "CHANGE-CAUSE", "NAME", "AGE"}
+
+type (
+	// HistoryView lists the rollout revisions of a workload.
+	historyView struct {
+		*tableView
+
+		revisions []k8s.Revision
+	}
+
+	// DiffLine represents a line of a text diff.
+	diffLine struct {
+		op   byte
+		text string
+	}
+)
+
+func newHistoryView(app *appView) *historyView {
+	v := historyView{tableView: newTableView(app, "History")}
+	v.SetColorerFn(historyColorer)
+	v.SetSortCol(0, len(historyHeader), false)
+
+	return &v
+}
+
+func (v *historyView) update(sel string, rr []k8s.Revision) {
+	v.revisions = rr
+	v.SetBaseTitle("History:" + sel)
+
+	data := resource.TableData{
+		Header:    historyHeader,
+		Rows:      make(resource.RowEvents, len(rr)),
+		NumCols:   map[string]bool{"REVISION": true},
+		Namespace: "*",
+	}
+	noDeltas := make(resource.Row, len(historyHeader))
+	for _, r := range rr {
+		var current string
+		if r.Current {
+			current = currentRevision
+		}
+		cause := r.ChangeCause
+		if cause == "" {
+			cause = resource.MissingValue
+		}
+		rev := strconv.FormatInt(r.Number, 10)
+		data.Rows[rev] = &resource.RowEvent{
+			Action: resource.Unchanged,
+			Fields: resource.Row{rev, current, cause, r.Name, time.Since(r.Created.Time).String()},
+			Deltas: noDeltas,
+		}
+	}
+	v.Update(data)
+	v.SelectRow(1, true)
+}
+
+// SelectedRevision returns the currently selected revision.
+func (v *historyView) selectedRevision() (k8s.Revision, bool) {
+	r, _ := v.GetSelection()
+	if r == 0 || v.GetCell(r, 0) == nil {
+		return k8s.Revision{}, false
+	}
+	rev, err := strconv.ParseInt(ui.TrimCell(v.Table, r, 0), 10, 64)
+	if err != nil {
+		return k8s.Revision{}, false
+	}
+	for _, r := range v.revisions {
+		if r.Number == rev {
+			return r, true
+		}
+	}
+
+	return k8s.Revision{}, false
+}
+
+// DiffBase returns the revision to compare a given revision against. ie the current
+// revision or the one preceding the current revision.
+func (v *historyView) diffBase(rev k8s.Revision) (k8s.Revision, bool) {
+	for i, r := range v.revisions {
+		if !r.Current {
+			continue
+		}
+		if r.Number != rev.Number {
+			return r, true
+		}
+		if i > 0 {
+			return v.revisions[i-1], true
+		}
+	}
+
+	return k8s.Revision{}, false
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// RevisionDiff renders the pod template differences between two revisions.
+func revisionDiff(from, to k8s.Revision) (string, error) {
+	f, err := yaml.Marshal(from.Template)
+	if err != nil {
+		return "", err
+	}
+	t, err := yaml.Marshal(to.Template)
+	if err != nil {
+		return "", err
+	}
+
+	dd := lineDiff(splitLines(string(f)), splitLines(string(t)))
+	lines := make([]string, 0, len(dd)+2)
+	lines = append(lines,
+		fmt.Sprintf("[orangered::b]--- revision %d[-::-]", from.Number),
+		fmt.Sprintf("[green::b]+++ revision %d[-::-]", to.Number),
+	)
+	for _, d := range dd {
+		l := tview.Escape(string(d.op) + " " + d.text)
+		switch d.op {
+		case '-':
+			l = "[orangered::]" + l + "[-::]"
+		case '+':
+			l = "[green::]" + l + "[-::]"
+		}
+		lines = append(lines, l)
+	}
+
+	return strings.Join(lines, "\n"), nil
+}
+
+func splitLines(s string) []string {
+	return strings.Split(strings.TrimRight(s, "\n"), "\n")
+}
+
+// LineDiff computes a line based diff using the longest common subsequence.
+func lineDiff(a, b []string) []diffLine {
+	lcs := make([][]int, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+				continue
+			}
+			lcs[i][j] = lcs[i+1][j]
+			if lcs[i][j+1] > lcs[i][j] {
+				lcs[i][j] = lcs[i][j+1]
+			}
+		}
+	}
+
+	dd := make([]diffLine, 0, len(a)+len(b))
+	var i, j int
+	for i < len(a) && j < len(b) {
+		switch {
+		case a[i] == b[j]:
+			dd = append(dd, diffLine{op: ' ', text: a[i]})
+			i, j = i+1, j+1
+		case lcs[i+1][j] >= lcs[i][j+1]:
+			dd = append(dd, diffLine{op: '-', text: a[i]})
+			i++
+		default:
+			dd = append(dd, diffLine{op: '+', text: b[j]})
+			j++
+		}
+	}
+	for ; i < len(a); i++ {
+		dd = append(dd, diffLine{op: '-', text: a[i]})
+	}
+	for ; j < len(b); j++ {
+		dd = append(dd, diffLine{op: '+', text: b[j]})
+	}
+
+	return dd
+}
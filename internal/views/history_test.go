@@ -0,0 +1,92 @@
+package views
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/k8s"
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+)
+
+func TestLineDiff(t *testing.T) {
+	uu := map[string]struct {
+		a, b []string
+		e    []diffLine
+	}{
+		"same": {
+			a: []string{"a", "b"},
+			b: []string{"a", "b"},
+			e: []diffLine{{' ', "a"}, {' ', "b"}},
+		},
+		"changed": {
+			a: []string{"a", "b", "c"},
+			b: []string{"a", "x", "c"},
+			e: []diffLine{{' ', "a"}, {'-', "b"}, {'+', "x"}, {' ', "c"}},
+		},
+		"added": {
+			a: []string{"a"},
+			b: []string{"a", "b"},
+			e: []diffLine{{' ', "a"}, {'+', "b"}},
+		},
+		"deleted": {
+			a: []string{"a", "b"},
+			b: []string{"b"},
+			e: []diffLine{{'-', "a"}, {' ', "b"}},
+		},
+	}
+
+	for k, u := range uu {
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, lineDiff(u.a, u.b))
+		})
+	}
+}
+
+func TestRevisionDiff(t *testing.T) {
+	r1, r2 := testRevision(1, "fred:1", false), testRevision(2, "fred:2", true)
+
+	diff, err := revisionDiff(r1, r2)
+	assert.Nil(t, err)
+	assert.Contains(t, diff, "[orangered::]-   - image: fred:1[-::]")
+	assert.Contains(t, diff, "[green::]+   - image: fred:2[-::]")
+	assert.Contains(t, diff, "[orangered::b]--- revision 1[-::-]")
+	assert.Contains(t, diff, "[green::b]+++ revision 2[-::-]")
+}
+
+func TestHistoryDiffBase(t *testing.T) {
+	v := historyView{revisions: []k8s.Revision{
+		testRevision(1, "fred:1", false),
+		testRevision(2, "fred:2", false),
+		testRevision(3, "fred:3", true),
+	}}
+
+	uu := map[string]struct {
+		rev  int
+		base int64
+		ok   bool
+	}{
+		"old":     {rev: 0, base: 3, ok: true},
+		"current": {rev: 2, base: 2, ok: true},
+	}
+
+	for k, u := range uu {
+		t.Run(k, func(t *testing.T) {
+			b, ok := v.diffBase(v.revisions[u.rev])
+			assert.Equal(t, u.ok, ok)
+			assert.Equal(t, u.base, b.Number)
+		})
+	}
+
+	single := historyView{revisions: []k8s.Revision{testRevision(1, "fred:1", true)}}
+	_, ok := single.diffBase(single.revisions[0])
+	assert.False(t, ok)
+}
+
+// Helpers...
+
+func testRevision(n int64, img string, current bool) k8s.Revision {
+	r := k8s.Revision{Number: n, Current: current}
+	r.Template.Spec.Containers = []v1.Container{{Name: "c1", Image: img}}
+
+	return r
+}
@@ -0,0 +1,47 @@
+package views
+
+import (
+	"errors"
+
+	"github.com/derailed/k9s/internal/k8s"
+	"github.com/derailed/k9s/internal/resource"
+	"github.com/derailed/k9s/internal/ui/dialog"
+	"github.com/gdamore/tcell"
+)
+
+func (v *resourceView) setImageCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if !v.masterPage().RowSelected() {
+		return evt
+	}
+
+	sel := v.masterPage().GetSelectedItem()
+	r, ok := v.list.Resource().(resource.ImageSetter)
+	if !ok {
+		v.app.Flash().Err(errors.New("resource is not of type resource.ImageSetter"))
+		return nil
+	}
+	ns, n := namespaced(sel)
+	ii, err := r.Images(ns, n)
+	if err != nil {
+		v.app.Flash().Errf("Unable to fetch container images %s", err)
+		return nil
+	}
+
+	msg := "Set image for " + sel
+	dialog.ShowImages(v.Pages, msg, ii, func(cc []k8s.ContainerImage) {
+		if len(cc) == 0 {
+			v.app.Flash().Info("No image changes...")
+			return
+		}
+		if err := r.SetImages(ns, n, cc); err != nil {
+			v.app.Flash().Errf("Set image failed %s", err)
+			return
+		}
+		v.app.Flash().Infof("Image updated for %s", sel)
+		if _, ok := v.list.Resource().(resource.Trackable); ok {
+			v.showRollout(sel)
+		}
+	})
+
+	return nil
+}
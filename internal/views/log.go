@@ -5,7 +5,10 @@ import (
 	"io"
 	"os"
 	"path/filepath"
+	"regexp"
+	"strconv"
 	"strings"
+	"sync"
 	"sync/atomic"
 	"time"
 
@@ -33,9 +36,82 @@ type (
 		ansiWriter io.Writer
 		autoScroll int32
 		path       string
+		container  string
+		window     string
+		cmdBuff    *ui.CmdBuff
+		mx         sync.Mutex
+		lines      []string
+		maxLines   int
+		numMatches int
+		jsonMode   jsonMode
+		wrap       bool
+
+		timestampsFn func()
 	}
+
+	logFilter struct {
+		rx, valueRX *regexp.Regexp
+		field       string
+		inverse     bool
+	}
+)
+
+const inverseFilter = "!"
+
+var (
+	ansiRX        = regexp.MustCompile(`\x1b\[[0-9;]*[a-zA-Z]`)
+	logHeaderRX   = regexp.MustCompile(`\A\x1b\[[0-9;]*m[^\x1b]* \x1b\[0m`)
+	fieldFilterRX = regexp.MustCompile(`\A([\w\.\-]+)=(.+)\z`)
 )
 
+// NewLogFilter parses a log filter expression. Expressions prefixed with ! exclude
+// matching lines. Expressions of the form field=value match JSON log field values.
+func newLogFilter(q string) *logFilter {
+	var f logFilter
+	if strings.HasPrefix(q, inverseFilter) {
+		f.inverse, q = true, strings.TrimPrefix(q, inverseFilter)
+	}
+	if q == "" {
+		return nil
+	}
+
+	f.rx = filterRX(q)
+	if mm := fieldFilterRX.FindStringSubmatch(q); len(mm) == 3 {
+		f.field, f.valueRX = mm[1], filterRX(mm[2])
+	}
+
+	return &f
+}
+
+func filterRX(q string) *regexp.Regexp {
+	rx, err := regexp.Compile(`(?i)` + q)
+	if err != nil {
+		rx = regexp.MustCompile(`(?i)` + regexp.QuoteMeta(q))
+	}
+
+	return rx
+}
+
+func (f *logFilter) matches(l string) bool {
+	raw := ansiRX.ReplaceAllString(l, "")
+	if f.field != "" {
+		if _, m, ok := parseJSONLine(raw); ok {
+			v, ok := fieldValue(m, f.field)
+			return ok && f.valueRX.MatchString(v)
+		}
+	}
+
+	return f.rx.MatchString(raw)
+}
+
+func (f *logFilter) highlightRX() *regexp.Regexp {
+	if f.field != "" {
+		return f.valueRX
+	}
+
+	return f.rx
+}
+
 func newLogFrame(app *appView, backFn ui.ActionHandler) *logFrame {
 	f := logFrame{
 		Flex:    tview.NewFlex(),
@@ -55,7 +131,12 @@ func newLogView(_ string, app *appView, backFn ui.ActionHandler) *logView {
 	v := logView{
 		logFrame:   newLogFrame(app, backFn),
 		autoScroll: 1,
+		wrap:       true,
+		maxLines:   app.Config.K9s.LogBufferSize,
+		cmdBuff:    ui.NewCmdBuff('/', ui.FilterBuff),
 	}
+	v.cmdBuff.AddListener(app.Cmd())
+	v.cmdBuff.AddListener(&v)
 
 	v.logs = newDetailsView(app, backFn)
 	{
@@ -64,7 +145,7 @@ func newLogView(_ string, app *appView, backFn ui.ActionHandler) *logView {
 		v.logs.SetDynamicColors(true)
 		v.logs.SetTextColor(config.AsColor(app.Styles.Views().Log.FgColor))
 		v.logs.SetBackgroundColor(config.AsColor(app.Styles.Views().Log.BgColor))
-		v.logs.SetWrap(true)
+		v.logs.SetWrap(v.wrap)
 		v.logs.SetMaxBuffer(app.Config.K9s.LogBufferSize)
 	}
 	v.ansiWriter = tview.ANSIWriter(v.logs, app.Styles.Views().Log.FgColor, app.Styles.Views().Log.BgColor)
@@ -80,28 +161,56 @@ func newLogView(_ string, app *appView, backFn ui.ActionHandler) *logView {
 
 func (v *logView) bindKeys() {
 	v.actions = ui.KeyActions{
-		tcell.KeyEscape: ui.NewKeyAction("Back", v.backCmd, true),
-		ui.KeyC:         ui.NewKeyAction("Clear", v.clearCmd, true),
-		ui.KeyS:         ui.NewKeyAction("Toggle AutoScroll", v.toggleScrollCmd, true),
-		ui.KeyG:         ui.NewKeyAction("Top", v.topCmd, false),
-		ui.KeyShiftG:    ui.NewKeyAction("Bottom", v.bottomCmd, false),
-		ui.KeyF:         ui.NewKeyAction("Up", v.pageUpCmd, false),
-		ui.KeyB:         ui.NewKeyAction("Down", v.pageDownCmd, false),
-		tcell.KeyCtrlS:  ui.NewKeyAction("Save", v.saveCmd, true),
+		tcell.KeyEscape:     ui.NewKeyAction("Back", v.backCmd, true),
+		ui.KeyC:             ui.NewKeyAction("Clear", v.clearCmd, true),
+		ui.KeyS:             ui.NewKeyAction("Toggle AutoScroll", v.toggleScrollCmd, true),
+		ui.KeyG:             ui.NewKeyAction("Top", v.topCmd, false),
+		ui.KeyShiftG:        ui.NewKeyAction("Bottom", v.bottomCmd, false),
+		ui.KeyF:             ui.NewKeyAction("Up", v.pageUpCmd, false),
+		ui.KeyB:             ui.NewKeyAction("Down", v.pageDownCmd, false),
+		tcell.KeyCtrlS:      ui.NewKeyAction("Save", v.saveCmd, true),
+		ui.KeySlash:         ui.NewKeyAction("Filter Mode", v.activateCmd, false),
+		tcell.KeyEnter:      ui.NewKeyAction("Filter", v.filterCmd, false),
+		tcell.KeyBackspace2: ui.NewKeyAction("Erase", v.eraseCmd, false),
+		tcell.KeyBackspace:  ui.NewKeyAction("Erase", v.eraseCmd, false),
+		tcell.KeyDelete:     ui.NewKeyAction("Erase", v.eraseCmd, false),
+		tcell.KeyTab:        ui.NewKeyAction("Next Match", v.nextCmd, false),
+		tcell.KeyBacktab:    ui.NewKeyAction("Previous Match", v.prevCmd, false),
+		ui.KeyJ:             ui.NewKeyAction("JSON Mode", v.jsonCmd, true),
+		ui.KeyT:             ui.NewKeyAction("Toggle Timestamps", v.toggleTimestampsCmd, true),
+		ui.KeyW:             ui.NewKeyAction("Toggle Wrap", v.toggleWrapCmd, true),
 	}
 }
 
 func (v *logView) setTitle(path, co string) {
+	v.path, v.container = path, co
+	v.refreshTitle()
+}
+
+func (v *logView) refreshTitle() {
 	var fmat string
-	if co == "" {
-		fmat = skinTitle(fmt.Sprintf(logFmt, path), v.app.Styles.Frame())
+	if v.container == "" {
+		fmat = skinTitle(fmt.Sprintf(logFmt, v.path), v.app.Styles.Frame())
 	} else {
-		fmat = skinTitle(fmt.Sprintf(logCoFmt, path, co), v.app.Styles.Frame())
+		fmat = skinTitle(fmt.Sprintf(logCoFmt, v.path, v.container), v.app.Styles.Frame())
+	}
+	if !v.cmdBuff.Empty() {
+		fmat += skinTitle(fmt.Sprintf(searchFmt, v.cmdBuff.String()), v.app.Styles.Frame())
 	}
-	v.path = path
 	v.SetTitle(fmat)
 }
 
+// BufferChanged indicates the buffer was changed.
+func (v *logView) BufferChanged(s string) {
+	v.refilter()
+	v.refreshTitle()
+}
+
+// BufferActive indicates the buff activity changed.
+func (v *logView) BufferActive(state bool, k ui.BufferKind) {
+	v.app.BufferActive(state, k)
+}
+
 // Hints show action hints
 func (v *logView) Hints() ui.Hints {
 	return v.actions.Hints()
@@ -110,6 +219,10 @@ func (v *logView) Hints() ui.Hints {
 func (v *logView) keyboard(evt *tcell.EventKey) *tcell.EventKey {
 	key := evt.Key()
 	if key == tcell.KeyRune {
+		if v.cmdBuff.IsActive() {
+			v.cmdBuff.Add(evt.Rune())
+			return nil
+		}
 		key = tcell.Key(evt.Rune())
 	}
 	if m, ok := v.actions[key]; ok {
@@ -130,13 +243,131 @@ func (v *logView) flush(index int, buff []string) {
 		return
 	}
 
-	if atomic.LoadInt32(&v.autoScroll) == 1 {
-		v.log(strings.Join(buff[:index], "\n"))
-		v.app.QueueUpdateDraw(func() {
-			v.updateIndicator()
-			v.logs.ScrollToEnd()
-		})
+	v.mx.Lock()
+	overflow := v.record(buff[:index])
+	if atomic.LoadInt32(&v.autoScroll) == 0 {
+		v.mx.Unlock()
+		return
+	}
+	if overflow && (!v.cmdBuff.Empty() || v.jsonMode != jsonRaw) {
+		v.render(v.lines)
+	} else {
+		v.show(buff[:index])
+	}
+	v.mx.Unlock()
+
+	v.app.QueueUpdateDraw(func() {
+		v.updateIndicator()
+		v.logs.ScrollToEnd()
+	})
+}
+
+// Record tracks the latest log lines up to the log buffer size and
+// reports if older lines were evicted.
+func (v *logView) record(lines []string) bool {
+	v.lines = append(v.lines, lines...)
+	if v.maxLines <= 0 || len(v.lines) <= v.maxLines {
+		return false
 	}
+	v.lines = append([]string(nil), v.lines[len(v.lines)-v.maxLines:]...)
+
+	return true
+}
+
+// Refilter redraws all buffered lines using the current filter.
+func (v *logView) refilter() {
+	v.mx.Lock()
+	defer v.mx.Unlock()
+
+	v.render(v.lines)
+	if v.numMatches > 0 {
+		v.logs.Highlight("0").ScrollToHighlight()
+	} else {
+		v.logs.ScrollToEnd()
+	}
+}
+
+func (v *logView) render(lines []string) {
+	v.logs.Clear()
+	v.logs.Highlight()
+	v.numMatches = 0
+	v.show(lines)
+}
+
+// Show writes out the given lines that match the current filter if any.
+func (v *logView) show(lines []string) {
+	f := newLogFilter(v.cmdBuff.String())
+	if f == nil && v.jsonMode == jsonRaw {
+		v.log(strings.Join(lines, "\n"))
+		return
+	}
+
+	out := make([]string, 0, len(lines))
+	for _, l := range lines {
+		if f != nil && f.matches(l) == f.inverse {
+			continue
+		}
+		prefix, body, color := v.format(l)
+		if f != nil && !f.inverse {
+			body = v.highlight(f.highlightRX(), ansiRX.ReplaceAllString(body, ""))
+		}
+		if color != "" {
+			body = "[" + color + "::]" + body + "[-::]"
+		}
+		out = append(out, prefix+body)
+	}
+	if len(out) == 0 {
+		return
+	}
+	fmt.Fprintln(v.ansiWriter, strings.Join(out, "\n"))
+}
+
+// Format renders a log line given the current JSON mode. It returns the line
+// decoration, its escaped body and an optional level color.
+func (v *logView) format(l string) (string, string, string) {
+	if v.jsonMode == jsonRaw {
+		header, msg := splitLogHeader(l)
+		return tview.Escape(header), tview.Escape(msg), ""
+	}
+	prefix, m, ok := parseJSONLine(l)
+	if !ok {
+		header, msg := splitLogHeader(l)
+		return tview.Escape(header), tview.Escape(msg), ""
+	}
+
+	cfg, co := v.app.Config.K9s.JSONLog, v.container
+	if co == "" {
+		co = logContainer(prefix, cfg)
+	}
+	body := formatJSON(m, v.jsonMode, cfg.FieldsFor(co))
+
+	return tview.Escape(prefix), body, levelColor(v.app.Styles.Views().Log, logLevel(m))
+}
+
+// SplitLogHeader splits off the colored pod/container header from a log line.
+func splitLogHeader(l string) (string, string) {
+	loc := logHeaderRX.FindStringIndex(l)
+	if loc == nil {
+		return "", l
+	}
+
+	return l[:loc[1]], l[loc[1]:]
+}
+
+func (v *logView) highlight(rx *regexp.Regexp, l string) string {
+	return rx.ReplaceAllStringFunc(l, func(m string) string {
+		if m == "" {
+			return m
+		}
+		id := v.numMatches
+		v.numMatches++
+		return `["` + strconv.Itoa(id) + `"]` + m + `[""]`
+	})
+}
+
+func (v *logView) setWindow(w string) {
+	v.window = w
+	v.updateIndicator()
 }
 
 func (v *logView) updateIndicator() {
@@ -144,7 +375,14 @@ func (v *logView) updateIndicator() {
 	if v.autoScroll == 1 {
 		status = "On"
 	}
-	v.status.update([]string{fmt.Sprintf("Autoscroll: %s", status)})
+	var ss []string
+	if v.window != "" {
+		ss = append(ss, v.window)
+	}
+	if v.jsonMode != jsonRaw {
+		ss = append(ss, "JSON: "+v.jsonMode.String())
+	}
+	v.status.update(append(ss, fmt.Sprintf("Autoscroll: %s", status)))
 }
 
 // ----------------------------------------------------------------------------
@@ -191,6 +429,124 @@ func saveData(cluster, name, data string) (string, error) {
 	return path, nil
 }
 
+func (v *logView) activateCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if v.app.InCmdMode() {
+		return evt
+	}
+
+	v.app.Flash().Info("Filter mode activated. Use !expr to exclude matches.")
+	v.cmdBuff.SetActive(true)
+
+	return nil
+}
+
+func (v *logView) filterCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if !v.cmdBuff.IsActive() {
+		return evt
+	}
+	v.cmdBuff.SetActive(false)
+
+	v.mx.Lock()
+	n := v.numMatches
+	v.mx.Unlock()
+	if f := newLogFilter(v.cmdBuff.String()); f != nil && !f.inverse {
+		if n == 0 {
+			v.app.Flash().Warn("No matches found!")
+		} else {
+			v.app.Flash().Infof("Found <%d> matches! <tab>/<TAB> for next/previous", n)
+		}
+	}
+
+	return nil
+}
+
+func (v *logView) eraseCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if v.cmdBuff.IsActive() {
+		v.cmdBuff.Delete()
+	}
+
+	return nil
+}
+
+func (v *logView) nextCmd(evt *tcell.EventKey) *tcell.EventKey {
+	return v.gotoMatch(1)
+}
+
+func (v *logView) prevCmd(evt *tcell.EventKey) *tcell.EventKey {
+	return v.gotoMatch(-1)
+}
+
+func (v *logView) gotoMatch(delta int) *tcell.EventKey {
+	v.mx.Lock()
+	n := v.numMatches
+	v.mx.Unlock()
+	if n == 0 {
+		return nil
+	}
+
+	current := -1
+	if hh := v.logs.GetHighlights(); len(hh) > 0 {
+		current, _ = strconv.Atoi(hh[0])
+	}
+	index, wrapped := nextMatch(current, delta, n)
+	switch {
+	case wrapped && delta > 0:
+		v.app.Flash().Info("Search hit BOTTOM, continuing at TOP")
+	case wrapped && delta < 0:
+		v.app.Flash().Info("Search hit TOP, continuing at BOTTOM")
+	}
+	if atomic.LoadInt32(&v.autoScroll) == 1 {
+		atomic.StoreInt32(&v.autoScroll, 0)
+		v.updateIndicator()
+	}
+	v.logs.Highlight(strconv.Itoa(index)).ScrollToHighlight()
+
+	return nil
+}
+
+func (v *logView) jsonCmd(evt *tcell.EventKey) *tcell.EventKey {
+	v.mx.Lock()
+	v.jsonMode = v.jsonMode.next()
+	mode := v.jsonMode
+	v.mx.Unlock()
+
+	v.app.Flash().Infof("JSON logs rendering set to %s", mode)
+	v.refilter()
+	v.updateIndicator()
+
+	return nil
+}
+
+// Reset clears out all buffered log lines.
+func (v *logView) reset() {
+	v.mx.Lock()
+	defer v.mx.Unlock()
+
+	v.lines, v.numMatches = nil, 0
+	v.logs.Clear()
+}
+
+func (v *logView) toggleTimestampsCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if v.timestampsFn == nil {
+		return evt
+	}
+	v.timestampsFn()
+
+	return nil
+}
+
+func (v *logView) toggleWrapCmd(evt *tcell.EventKey) *tcell.EventKey {
+	v.wrap = !v.wrap
+	v.logs.SetWrap(v.wrap)
+	if v.wrap {
+		v.app.Flash().Info("Line wrap is on.")
+	} else {
+		v.app.Flash().Info("Line wrap is off.")
+	}
+
+	return nil
+}
+
 func (v *logView) toggleScrollCmd(evt *tcell.EventKey) *tcell.EventKey {
 	if atomic.LoadInt32(&v.autoScroll) == 0 {
 		atomic.StoreInt32(&v.autoScroll, 1)
@@ -200,6 +556,10 @@ func (v *logView) toggleScrollCmd(evt *tcell.EventKey) *tcell.EventKey {
 
 	if atomic.LoadInt32(&v.autoScroll) == 1 {
 		v.app.Flash().Info("Autoscroll is on.")
+		// Catch up on lines received while autoscroll was off.
+		v.mx.Lock()
+		v.render(v.lines)
+		v.mx.Unlock()
 		v.logs.ScrollToEnd()
 	} else {
 		v.logs.LineUp()
@@ -211,6 +571,13 @@ func (v *logView) toggleScrollCmd(evt *tcell.EventKey) *tcell.EventKey {
 }
 
 func (v *logView) backCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if !v.cmdBuff.Empty() {
+		v.app.Flash().Info("Clearing filter...")
+		v.cmdBuff.Reset()
+		return nil
+	}
+	v.cmdBuff.Reset()
+
 	return v.backFn(evt)
 }
 
@@ -242,7 +609,21 @@ func (v *logView) pageDownCmd(*tcell.EventKey) *tcell.EventKey {
 
 func (v *logView) clearCmd(*tcell.EventKey) *tcell.EventKey {
 	v.app.Flash().Info("Clearing logs...")
-	v.logs.Clear()
+	v.reset()
 	v.logs.ScrollTo(0, 0)
 	return nil
 }
+
+// NextMatch returns the match index delta away from the current one and whether
+// the search wrapped around. A negative current index means no match is selected.
+func nextMatch(current, delta, n int) (int, bool) {
+	if current < 0 || current >= n {
+		if delta < 0 {
+			return n - 1, false
+		}
+		return 0, false
+	}
+	index := current + delta
+
+	return (index%n + n) % n, index < 0 || index >= n
+}
@@ -0,0 +1,155 @@
+package views
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/tview"
+)
+
+const (
+	jsonRaw jsonMode = iota
+	jsonFields
+	jsonPretty
+)
+
+var levelKeys = []string{"level", "lvl", "severity", "loglevel"}
+
+type jsonMode int
+
+// String returns the mode display name.
+func (m jsonMode) String() string {
+	switch m {
+	case jsonFields:
+		return "Fields"
+	case jsonPretty:
+		return "Pretty"
+	default:
+		return "Raw"
+	}
+}
+
+func (m jsonMode) next() jsonMode {
+	return (m + 1) % 3
+}
+
+// ParseJSONLine splits out a log line decoration from its JSON payload if any.
+func parseJSONLine(l string) (string, map[string]interface{}, bool) {
+	i := strings.Index(l, "{")
+	if i == -1 || !strings.HasSuffix(strings.TrimSpace(l), "}") {
+		return l, nil, false
+	}
+
+	var m map[string]interface{}
+	if err := json.Unmarshal([]byte(l[i:]), &m); err != nil {
+		return l, nil, false
+	}
+
+	return l[:i], m, true
+}
+
+// FieldValue retrieves a field value given a dotted path ie http.status.
+func fieldValue(m map[string]interface{}, path string) (string, bool) {
+	var v interface{} = m
+	for _, k := range strings.Split(path, ".") {
+		mm, ok := v.(map[string]interface{})
+		if !ok {
+			return "", false
+		}
+		if v, ok = mm[k]; !ok {
+			return "", false
+		}
+	}
+
+	switch t := v.(type) {
+	case string:
+		return t, true
+	case nil:
+		return "", true
+	case map[string]interface{}, []interface{}:
+		raw, err := json.Marshal(t)
+		if err != nil {
+			return "", false
+		}
+		return string(raw), true
+	default:
+		return fmt.Sprintf("%v", t), true
+	}
+}
+
+func logLevel(m map[string]interface{}) string {
+	for _, k := range levelKeys {
+		if v, ok := fieldValue(m, k); ok {
+			return strings.ToLower(v)
+		}
+	}
+
+	return ""
+}
+
+// LevelColor returns the skin color associated with a log level if any.
+func levelColor(style config.Log, level string) string {
+	switch {
+	case level == "":
+		return ""
+	case strings.HasPrefix(level, "err"), strings.HasPrefix(level, "fatal"),
+		strings.HasPrefix(level, "crit"), strings.HasPrefix(level, "panic"):
+		return style.ErrorColor
+	case strings.HasPrefix(level, "warn"):
+		return style.WarnColor
+	case strings.HasPrefix(level, "info"), level == "notice":
+		return style.InfoColor
+	case strings.HasPrefix(level, "debug"), strings.HasPrefix(level, "trace"):
+		return style.DebugColor
+	default:
+		return ""
+	}
+}
+
+// LogContainer guesses a log line container name from its decoration.
+func logContainer(prefix string, j *config.JSONLog) string {
+	for _, t := range strings.Fields(ansiRX.ReplaceAllString(prefix, "")) {
+		co := t[strings.LastIndex(t, ":")+1:]
+		if j.HasContainer(co) {
+			return co
+		}
+	}
+
+	return ""
+}
+
+// FormatJSON renders a JSON log payload given a mode and a set of fields.
+func formatJSON(m map[string]interface{}, mode jsonMode, fields []string) string {
+	if mode == jsonPretty {
+		raw, err := json.MarshalIndent(m, "", "  ")
+		if err != nil {
+			return ""
+		}
+		return tview.Escape(string(raw))
+	}
+
+	ss := make([]string, 0, len(fields))
+	for _, f := range fields {
+		if v, ok := fieldValue(m, f); ok && v != "" {
+			ss = append(ss, v)
+		}
+	}
+	if len(ss) > 0 {
+		return tview.Escape(strings.Join(ss, " "))
+	}
+
+	kk := make([]string, 0, len(m))
+	for k := range m {
+		kk = append(kk, k)
+	}
+	sort.Strings(kk)
+	for _, k := range kk {
+		v, _ := fieldValue(m, k)
+		ss = append(ss, k+"="+v)
+	}
+
+	return tview.Escape(strings.Join(ss, " "))
+}
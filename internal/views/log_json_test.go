@@ -0,0 +1,111 @@
+package views
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseJSONLine(t *testing.T) {
+	uu := map[string]struct {
+		l, prefix string
+		ok        bool
+	}{
+		"plain":     {"hello world", "hello world", false},
+		"json":      {`{"msg":"hello"}`, "", true},
+		"decorated": {`fred:nginx {"msg":"hello"}`, "fred:nginx ", true},
+		"broken":    {`{"msg":"hello"`, `{"msg":"hello"`, false},
+		"brackets":  {`[INFO] {not json}`, `[INFO] {not json}`, false},
+	}
+
+	for k, u := range uu {
+		t.Run(k, func(t *testing.T) {
+			prefix, _, ok := parseJSONLine(u.l)
+			assert.Equal(t, u.ok, ok)
+			assert.Equal(t, u.prefix, prefix)
+		})
+	}
+}
+
+func TestFieldValue(t *testing.T) {
+	_, m, ok := parseJSONLine(`{"msg":"hello","code":200,"http":{"method":"GET"},"ok":true,"nada":null}`)
+	assert.True(t, ok)
+
+	uu := map[string]struct {
+		path, e string
+		ok      bool
+	}{
+		"string": {"msg", "hello", true},
+		"number": {"code", "200", true},
+		"nested": {"http.method", "GET", true},
+		"object": {"http", `{"method":"GET"}`, true},
+		"bool":   {"ok", "true", true},
+		"null":   {"nada", "", true},
+		"none":   {"fred", "", false},
+		"deep":   {"msg.fred", "", false},
+	}
+
+	for k, u := range uu {
+		t.Run(k, func(t *testing.T) {
+			v, ok := fieldValue(m, u.path)
+			assert.Equal(t, u.ok, ok)
+			assert.Equal(t, u.e, v)
+		})
+	}
+}
+
+func TestLevelColor(t *testing.T) {
+	style := config.Log{ErrorColor: "red", WarnColor: "orange", InfoColor: "white", DebugColor: "gray"}
+
+	uu := map[string]string{
+		"":        "",
+		"error":   "red",
+		"fatal":   "red",
+		"warning": "orange",
+		"info":    "white",
+		"debug":   "gray",
+		"trace":   "gray",
+		"blee":    "",
+	}
+	for l, e := range uu {
+		assert.Equal(t, e, levelColor(style, l))
+	}
+}
+
+func TestFormatJSON(t *testing.T) {
+	_, m, _ := parseJSONLine(`{"time":"10:00","level":"info","msg":"hello","code":200}`)
+
+	assert.Equal(t, "10:00 info hello", formatJSON(m, jsonFields, []string{"time", "level", "msg"}))
+	assert.Equal(t, "200 hello", formatJSON(m, jsonFields, []string{"code", "msg"}))
+	assert.Equal(t, "code=200 level=info msg=hello time=10:00", formatJSON(m, jsonFields, []string{"fred"}))
+	assert.Equal(t, "{\n  \"code\": 200,\n  \"level\": \"info\",\n  \"msg\": \"hello\",\n  \"time\": \"10:00\"\n}", formatJSON(m, jsonPretty, nil))
+}
+
+func TestLogContainer(t *testing.T) {
+	j := config.NewJSONLog()
+	j.Containers["nginx"] = []string{"msg"}
+
+	assert.Equal(t, "nginx", logContainer("fred-123:nginx ", j))
+	assert.Equal(t, "nginx", logContainer("\x1b[32mnginx \x1b[0m", j))
+	assert.Equal(t, "", logContainer("fred-123:blee ", j))
+}
+
+func TestLogViewJSON(t *testing.T) {
+	v := newLogView("Logs", NewApp(config.NewConfig(ks{})), nil)
+	v.flush(3, []string{
+		`{"level":"info","msg":"blee"}`,
+		`{"level":"error","msg":"bozo"}`,
+		"not json",
+	})
+
+	v.jsonCmd(nil)
+	assert.Equal(t, jsonFields, v.jsonMode)
+	assert.Equal(t, "info blee\nerror bozo\nnot json\n", v.logs.GetText(true))
+
+	v.cmdBuff.Set("level=error")
+	assert.Equal(t, "error bozo\n", v.logs.GetText(true))
+
+	v.cmdBuff.Set("!level=error")
+	assert.Equal(t, "info blee\nnot json\n", v.logs.GetText(true))
+}
@@ -5,10 +5,12 @@ import (
 	"fmt"
 	"io/ioutil"
 	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/derailed/k9s/internal/config"
 	"github.com/derailed/tview"
+	"github.com/gdamore/tcell"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -80,3 +82,126 @@ func TestLogViewClear(t *testing.T) {
 	v.clearCmd(nil)
 	assert.Equal(t, "", v.logs.GetText(true))
 }
+
+func TestLogViewFilter(t *testing.T) {
+	v := newLogView("Logs", NewApp(config.NewConfig(ks{})), nil)
+	v.flush(3, []string{"blee", "bozo", "blee bozo"})
+
+	v.cmdBuff.Set("bozo")
+	assert.Equal(t, "bozo\nblee bozo\n", v.logs.GetText(true))
+	assert.Equal(t, 2, v.numMatches)
+
+	v.flush(2, []string{"fred", "BOZO"})
+	assert.Equal(t, "bozo\nblee bozo\nBOZO\n", v.logs.GetText(true))
+	assert.Equal(t, 3, v.numMatches)
+
+	v.cmdBuff.Set("!bozo")
+	assert.Equal(t, "blee\nfred\n", v.logs.GetText(true))
+	assert.Equal(t, 0, v.numMatches)
+
+	v.cmdBuff.Reset()
+	assert.Equal(t, "blee\nbozo\nblee bozo\nfred\nBOZO\n", v.logs.GetText(true))
+}
+
+func TestLogViewFilterDecorated(t *testing.T) {
+	v := newLogView("Logs", NewApp(config.NewConfig(ks{})), nil)
+	v.flush(2, []string{"\x1b[32mnginx-3:c1 \x1b[0mGET /3", "\x1b[33mnginx-4:c1 \x1b[0mGET /4"})
+
+	v.cmdBuff.Set("3")
+	assert.Equal(t, "nginx-3:c1 GET /3\n", v.logs.GetText(true))
+	assert.Equal(t, 1, v.numMatches)
+	assert.Equal(t, []string{"0"}, v.logs.GetHighlights())
+}
+
+func TestLogViewFilterTimestamps(t *testing.T) {
+	v := newLogView("Logs", NewApp(config.NewConfig(ks{})), nil)
+	v.flush(2, []string{
+		"\x1b[32mnginx-3:c1 \x1b[0m2019-10-01T10:00:00.300000000Z GET /3",
+		"\x1b[33mnginx-4:c1 \x1b[0m2019-10-01T10:00:00.400000000Z GET /4",
+	})
+
+	v.cmdBuff.Set("/3")
+	assert.Equal(t, "nginx-3:c1 2019-10-01T10:00:00.300000000Z GET /3\n", v.logs.GetText(true))
+	assert.True(t, strings.HasPrefix(v.logs.GetText(false), "[green:]nginx-3:c1 "))
+	assert.Equal(t, 1, v.numMatches)
+}
+
+func TestSplitLogHeader(t *testing.T) {
+	uu := map[string]struct {
+		l, header, msg string
+	}{
+		"plain":   {l: "blee", msg: "blee"},
+		"header":  {l: "\x1b[32mfred:c1 \x1b[0mblee", header: "\x1b[32mfred:c1 \x1b[0m", msg: "blee"},
+		"stamped": {l: "\x1b[32mfred:c1 \x1b[0m2019-10-01T10:00:00.3Z blee", header: "\x1b[32mfred:c1 \x1b[0m", msg: "2019-10-01T10:00:00.3Z blee"},
+		"colored": {l: "\x1b[31mblee\x1b[0m bozo", msg: "\x1b[31mblee\x1b[0m bozo"},
+	}
+
+	for k, u := range uu {
+		t.Run(k, func(t *testing.T) {
+			header, msg := splitLogHeader(u.l)
+			assert.Equal(t, u.header, header)
+			assert.Equal(t, u.msg, msg)
+		})
+	}
+}
+
+func TestLogViewFilterNav(t *testing.T) {
+	v := newLogView("Logs", NewApp(config.NewConfig(ks{})), nil)
+	v.flush(3, []string{"a1", "b", "a2"})
+	v.cmdBuff.Set("a")
+
+	assert.Equal(t, []string{"0"}, v.logs.GetHighlights())
+	v.nextCmd(nil)
+	assert.Equal(t, []string{"1"}, v.logs.GetHighlights())
+	v.nextCmd(nil)
+	assert.Equal(t, []string{"0"}, v.logs.GetHighlights())
+	v.prevCmd(nil)
+	assert.Equal(t, []string{"1"}, v.logs.GetHighlights())
+}
+
+func TestNextMatch(t *testing.T) {
+	uu := map[string]struct {
+		current, delta, n, index int
+		wrapped                  bool
+	}{
+		"firstNext":  {current: -1, delta: 1, n: 3, index: 0},
+		"firstPrev":  {current: -1, delta: -1, n: 3, index: 2},
+		"next":       {current: 0, delta: 1, n: 3, index: 1},
+		"prev":       {current: 2, delta: -1, n: 3, index: 1},
+		"wrapBottom": {current: 2, delta: 1, n: 3, index: 0, wrapped: true},
+		"wrapTop":    {current: 0, delta: -1, n: 3, index: 2, wrapped: true},
+		"stale":      {current: 5, delta: 1, n: 3, index: 0},
+	}
+
+	for k, u := range uu {
+		t.Run(k, func(t *testing.T) {
+			index, wrapped := nextMatch(u.current, u.delta, u.n)
+			assert.Equal(t, u.index, index)
+			assert.Equal(t, u.wrapped, wrapped)
+		})
+	}
+}
+
+func TestLogViewRecord(t *testing.T) {
+	v := newLogView("Logs", NewApp(config.NewConfig(ks{})), nil)
+	v.maxLines = 3
+
+	assert.False(t, v.record([]string{"a", "b"}))
+	assert.True(t, v.record([]string{"c", "d"}))
+	assert.Equal(t, []string{"b", "c", "d"}, v.lines)
+}
+
+func TestLogViewToggles(t *testing.T) {
+	v := newLogView("Logs", NewApp(config.NewConfig(ks{})), nil)
+
+	assert.True(t, v.wrap)
+	v.toggleWrapCmd(nil)
+	assert.False(t, v.wrap)
+
+	evt := tcell.NewEventKey(tcell.KeyRune, 't', tcell.ModNone)
+	assert.Equal(t, evt, v.toggleTimestampsCmd(evt))
+	var toggled bool
+	v.timestampsFn = func() { toggled = !toggled }
+	assert.Nil(t, v.toggleTimestampsCmd(nil))
+	assert.True(t, toggled)
+}
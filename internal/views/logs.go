@@ -3,10 +3,12 @@ package views
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/derailed/k9s/internal/resource"
 	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/k9s/internal/ui/dialog"
 	"github.com/derailed/tview"
 	"github.com/gdamore/tcell"
 	"github.com/rs/zerolog/log"
@@ -33,6 +35,11 @@ type (
 		parent     loggable
 		actions    ui.KeyActions
 		cancelFunc context.CancelFunc
+		container  string
+		previous   bool
+		since      string
+		until      string
+		timestamps bool
 	}
 )
 
@@ -49,12 +56,20 @@ func newLogsView(title string, app *appView, parent loggable) *logsView {
 // Protocol...
 
 func (v *logsView) reload(co string, parent loggable, prevLogs bool) {
-	v.parent = parent
+	v.parent, v.container, v.previous = parent, co, prevLogs
 	v.deletePage()
-	v.AddPage("logs", newLogView(co, v.app, v.backCmd), true, true)
+	l := newLogView(co, v.app, v.backCmd)
+	l.actions[ui.KeyShiftW] = ui.NewKeyAction("Time Window", v.windowCmd, true)
+	l.timestampsFn = v.toggleTimestamps
+	v.AddPage("logs", l, true, true)
 	v.load(co, prevLogs)
 }
 
+// Restart reopens the log stream while keeping the current log filter and display modes.
+func (v *logsView) restart() {
+	v.load(v.container, v.previous)
+}
+
 // SetActions to handle keyboard events.
 func (v *logsView) setActions(aa ui.KeyActions) {
 	v.actions = aa
@@ -97,8 +112,9 @@ func (v *logsView) doLoad(path, co string, prevLogs bool) error {
 	v.stop()
 
 	l := v.CurrentPage().Item.(*logView)
-	l.logs.Clear()
+	l.reset()
 	l.setTitle(path, co)
+	l.setWindow(v.windowInfo())
 
 	var ctx context.Context
 	ctx = context.WithValue(context.Background(), resource.IKey("informer"), v.app.informer)
@@ -113,7 +129,13 @@ func (v *logsView) doLoad(path, co string, prevLogs bool) error {
 		return fmt.Errorf("Resource %T is not tailable", v.parent.getList().Resource())
 	}
 
-	if err := res.Logs(ctx, c, v.logOpts(path, co, prevLogs)); err != nil {
+	opts, err := v.logOpts(path, co, prevLogs)
+	if err != nil {
+		v.cancelFunc()
+		close(c)
+		return err
+	}
+	if err := res.Logs(ctx, c, opts); err != nil {
 		v.cancelFunc()
 		close(c)
 		return err
@@ -122,16 +144,32 @@ func (v *logsView) doLoad(path, co string, prevLogs bool) error {
 	return nil
 }
 
-func (v *logsView) logOpts(path, co string, prevLogs bool) resource.LogOptions {
+func (v *logsView) logOpts(path, co string, prevLogs bool) (resource.LogOptions, error) {
 	ns, po := namespaced(path)
-	return resource.LogOptions{
+	opts := resource.LogOptions{
 		Fqn: resource.Fqn{
 			Namespace: ns,
 			Name:      po,
 			Container: co,
 		},
-		Lines:    int64(v.app.Config.K9s.LogRequestSize),
-		Previous: prevLogs,
+		Lines:      int64(v.app.Config.K9s.LogRequestSize),
+		Previous:   prevLogs,
+		Timestamps: v.timestamps,
+	}
+
+	return opts, opts.SetWindow(v.since, v.until)
+}
+
+func (v *logsView) windowInfo() string {
+	switch {
+	case v.since == "" && v.until == "":
+		return ""
+	case v.until == "":
+		return "Since: " + v.since
+	case v.since == "":
+		return "Until: " + v.until
+	default:
+		return v.since + " → " + v.until
 	}
 }
 
@@ -169,8 +207,39 @@ func updateLogs(ctx context.Context, c <-chan string, l *logView, buffSize int)
 // ----------------------------------------------------------------------------
 // Actions...
 
+func (v *logsView) windowCmd(evt *tcell.EventKey) *tcell.EventKey {
+	dialog.ShowLogWindow(v.Pages, v.since, v.until, func(since, until string) {
+		var opts resource.LogOptions
+		if err := opts.SetWindow(since, until); err != nil {
+			v.app.Flash().Err(err)
+			return
+		}
+		dialog.DismissLogWindow(v.Pages)
+		v.since, v.until = strings.TrimSpace(since), strings.TrimSpace(until)
+		if info := v.windowInfo(); info != "" {
+			v.app.Flash().Infof("Log window set to %s", info)
+		} else {
+			v.app.Flash().Info("Log window cleared.")
+		}
+		v.restart()
+	})
+
+	return nil
+}
+
+func (v *logsView) toggleTimestamps() {
+	v.timestamps = !v.timestamps
+	if v.timestamps {
+		v.app.Flash().Info("Log timestamps are on.")
+	} else {
+		v.app.Flash().Info("Log timestamps are off.")
+	}
+	v.restart()
+}
+
 func (v *logsView) backCmd(evt *tcell.EventKey) *tcell.EventKey {
 	v.stop()
+	v.since, v.until, v.timestamps = "", "", false
 	v.parent.switchPage("master")
 
 	return evt
@@ -7,6 +7,7 @@ import (
 	"testing"
 
 	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/resource"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -29,3 +30,30 @@ func TestUpdateLogs(t *testing.T) {
 
 	assert.Equal(t, 500, v.logs.GetLineCount())
 }
+
+func TestLogsViewRestart(t *testing.T) {
+	v := newLogsView("test", NewApp(config.NewConfig(ks{})), nil)
+	v.reload("c1", fakeLoggable{}, false)
+	l := v.CurrentPage().Item.(*logView)
+	l.cmdBuff.Set("blee")
+	l.jsonCmd(nil)
+	l.toggleWrapCmd(nil)
+
+	v.toggleTimestamps()
+
+	assert.True(t, v.timestamps)
+	assert.Equal(t, l, v.CurrentPage().Item.(*logView))
+	assert.Equal(t, "blee", l.cmdBuff.String())
+	assert.Equal(t, jsonFields, l.jsonMode)
+	assert.False(t, l.wrap)
+}
+
+// Helpers...
+
+type fakeLoggable struct{}
+
+func (fakeLoggable) getSelection() string { return "default/p1" }
+func (fakeLoggable) getList() resource.List {
+	return resource.NewList("default", "po", nil, 0)
+}
+func (fakeLoggable) switchPage(string) {}
@@ -1,17 +1,42 @@
 package views
 
 import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/derailed/k9s/internal/k8s"
 	"github.com/derailed/k9s/internal/resource"
 	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/k9s/internal/ui/dialog"
+	"github.com/derailed/tview"
 	"github.com/gdamore/tcell"
 )
 
-type nodeView struct {
-	*resourceView
-}
+const drainPage = "drain"
+
+type (
+	nodeView struct {
+		*resourceView
+
+		drainOpts k8s.DrainOptions
+	}
+
+	// DrainProgress tracks pod evictions while draining a node.
+	drainProgress struct {
+		node string
+		pods []string
+		sts  map[string]k8s.DrainStatus
+		err  error
+		done bool
+	}
+)
 
 func newNodeView(title, gvr string, app *appView, list resource.List) resourceViewer {
-	v := nodeView{newResourceView(title, gvr, app, list).(*resourceView)}
+	v := nodeView{
+		resourceView: newResourceView(title, gvr, app, list).(*resourceView),
+		drainOpts:    dialog.DefaultDrainOptions(),
+	}
 	v.extraActionsFn = v.extraActions
 	v.enterFn = v.showPods
 
@@ -23,6 +48,95 @@ func (v *nodeView) extraActions(aa ui.KeyActions) {
 	aa[ui.KeyShiftM] = ui.NewKeyAction("Sort MEM", v.sortColCmd(8, false), false)
 	aa[ui.KeyShiftX] = ui.NewKeyAction("Sort CPU%", v.sortColCmd(9, false), false)
 	aa[ui.KeyShiftZ] = ui.NewKeyAction("Sort MEM%", v.sortColCmd(10, false), false)
+	aa[ui.KeyO] = ui.NewKeyAction("Cordon", v.cordonCmd(true), true)
+	aa[ui.KeyU] = ui.NewKeyAction("Uncordon", v.cordonCmd(false), true)
+	aa[ui.KeyR] = ui.NewKeyAction("Drain", v.drainCmd, true)
+}
+
+func (v *nodeView) cordonCmd(cordon bool) func(evt *tcell.EventKey) *tcell.EventKey {
+	return func(evt *tcell.EventKey) *tcell.EventKey {
+		if !v.masterPage().RowSelected() {
+			return evt
+		}
+
+		r, ok := v.list.Resource().(resource.Drainable)
+		if !ok {
+			v.app.Flash().Err(errors.New("resource is not of type resource.Drainable"))
+			return nil
+		}
+		op := "cordoned"
+		if !cordon {
+			op = "uncordoned"
+		}
+		nn := v.masterPage().GetSelectedItems()
+		for _, n := range nn {
+			if err := r.Cordon(n, cordon); err != nil {
+				v.app.Flash().Errf("Unable to update node %s: %s", n, err)
+				return nil
+			}
+		}
+		v.app.Flash().Infof("Node %s %s", strings.Join(nn, ", "), op)
+		v.refresh()
+
+		return nil
+	}
+}
+
+func (v *nodeView) drainCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if !v.masterPage().RowSelected() {
+		return evt
+	}
+
+	sel := v.masterPage().GetSelectedItem()
+	msg := fmt.Sprintf("Drain node %s?", sel)
+	dialog.ShowDrain(v.Pages, msg, v.drainOpts, func(opts k8s.DrainOptions) {
+		v.drainOpts = opts
+		v.drain(sel, opts)
+	}, func(err error) {
+		v.app.Flash().Errf("Invalid drain options %s", err)
+	})
+
+	return nil
+}
+
+func (v *nodeView) drain(node string, opts k8s.DrainOptions) {
+	r, ok := v.list.Resource().(resource.Drainable)
+	if !ok {
+		v.app.Flash().Err(errors.New("resource is not of type resource.Drainable"))
+		return
+	}
+
+	details, ok := v.GetPrimitive(drainPage).(*detailsView)
+	if !ok {
+		details = newDetailsView(v.app, v.resourceView.backCmd)
+		v.AddPage(drainPage, details, true, false)
+	}
+	details.setCategory("Drain")
+	details.setTitle(node)
+	details.SetTextColor(v.app.Styles.FgColor())
+	p := newDrainProgress(node)
+	details.SetText(p.render())
+	v.app.SetHints(details.hints())
+	v.switchPage(drainPage)
+	v.app.Flash().Infof("Draining node %s...", node)
+
+	go func() {
+		err := r.Drain(node, opts, func(s k8s.DrainStatus) {
+			v.app.QueueUpdateDraw(func() {
+				p.update(s)
+				details.SetText(p.render())
+			})
+		})
+		v.app.QueueUpdateDraw(func() {
+			p.finish(err)
+			details.SetText(p.render())
+			if err != nil {
+				v.app.Flash().Errf("Drain failed %s", err)
+				return
+			}
+			v.app.Flash().Infof("Node %s drained", node)
+		})
+	}()
 }
 
 func (v *nodeView) sortColCmd(col int, asc bool) func(evt *tcell.EventKey) *tcell.EventKey {
@@ -61,3 +175,51 @@ func showPods(app *appView, ns, labelSel, fieldSel string, a ui.ActionHandler) {
 	app.Config.SetActiveNamespace(ns)
 	app.inject(pv)
 }
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func newDrainProgress(node string) *drainProgress {
+	return &drainProgress{node: node, sts: make(map[string]k8s.DrainStatus)}
+}
+
+func (p *drainProgress) update(s k8s.DrainStatus) {
+	if _, ok := p.sts[s.Pod]; !ok {
+		p.pods = append(p.pods, s.Pod)
+	}
+	p.sts[s.Pod] = s
+}
+
+func (p *drainProgress) finish(err error) {
+	p.done, p.err = true, err
+}
+
+func (p *drainProgress) render() string {
+	var evicted int
+	lines := make([]string, 0, len(p.pods)+2)
+	for _, po := range p.pods {
+		s := p.sts[po]
+		color := "orange"
+		switch {
+		case s.Err != nil:
+			color = "orangered"
+		case s.Status == "Evicted":
+			color, evicted = "green", evicted+1
+		}
+		l := fmt.Sprintf("[%s::]%-60s %s", color, po, s.Status)
+		if s.Err != nil {
+			l += " " + tview.Escape(s.Err.Error())
+		}
+		lines = append(lines, l)
+	}
+
+	summary := fmt.Sprintf("[aqua::b]Draining node %s... %d/%d pods evicted", p.node, evicted, len(p.pods))
+	switch {
+	case p.done && p.err != nil:
+		summary = fmt.Sprintf("[orangered::b]Drain failed: %s", tview.Escape(p.err.Error()))
+	case p.done:
+		summary = fmt.Sprintf("[green::b]Node %s drained. %d pods evicted", p.node, evicted)
+	}
+
+	return summary + "[-::-]\n\n" + strings.Join(lines, "\n")
+}
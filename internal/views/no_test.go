@@ -0,0 +1,36 @@
+package views
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/derailed/k9s/internal/k8s"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDrainProgress(t *testing.T) {
+	p := newDrainProgress("n1")
+	p.update(k8s.DrainStatus{Pod: "default/p1", Status: "Evicting"})
+	p.update(k8s.DrainStatus{Pod: "default/p2", Status: "Evicting"})
+	p.update(k8s.DrainStatus{Pod: "default/p1", Status: "Evicted"})
+
+	assert.Equal(t, []string{"default/p1", "default/p2"}, p.pods)
+	assert.Equal(t, "[aqua::b]Draining node n1... 1/2 pods evicted[-::-]\n\n"+
+		"[green::]default/p1                                                   Evicted\n"+
+		"[orange::]default/p2                                                   Evicting", p.render())
+
+	p.update(k8s.DrainStatus{Pod: "default/p2", Status: "Failed", Err: errors.New("boom")})
+	p.finish(errors.New("1 of 2 pods could not be evicted from node n1"))
+	assert.Equal(t, "[orangered::b]Drain failed: 1 of 2 pods could not be evicted from node n1[-::-]\n\n"+
+		"[green::]default/p1                                                   Evicted\n"+
+		"[orangered::]default/p2                                                   Failed boom", p.render())
+}
+
+func TestDrainProgressDone(t *testing.T) {
+	p := newDrainProgress("n1")
+	p.update(k8s.DrainStatus{Pod: "default/p1", Status: "Evicted"})
+	p.finish(nil)
+
+	assert.Equal(t, "[green::b]Node n1 drained. 1 pods evicted[-::-]\n\n"+
+		"[green::]default/p1                                                   Evicted", p.render())
+}
@@ -55,6 +55,7 @@ func (v *podView) extraActions(aa ui.KeyActions) {
 
 	aa[ui.KeyL] = ui.NewKeyAction("Logs", v.logsCmd, true)
 	aa[ui.KeyShiftL] = ui.NewKeyAction("Logs Previous", v.prevLogsCmd, true)
+	aa[ui.KeyI] = ui.NewKeyAction("Set Image", v.setImageCmd, true)
 
 	aa[ui.KeyShiftR] = ui.NewKeyAction("Sort Ready", v.sortColCmd(1, false), false)
 	aa[ui.KeyShiftS] = ui.NewKeyAction("Sort Status", v.sortColCmd(2, true), false)
@@ -0,0 +1,504 @@
+package views
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/k8s"
+	"github.com/derailed/k9s/internal/resource"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/k9s/internal/watch"
+	"github.com/derailed/tview"
+	"github.com/gdamore/tcell"
+	"github.com/rs/zerolog/log"
+	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+)
+
+const (
+	pulseTitle     = "Pulse"
+	pulseTitleFmt  = " [aqua::b]%s([fuchsia::b]%s[aqua::-])[aqua::-] "
+	pulseTrendSize = 40
+	pulseWindow    = 5 * time.Minute
+	pulseCols      = 3
+	// PulsePollRate throttles the resources not tracked by the informer.
+	pulsePollRate = 30 * time.Second
+	// PulseNoMatch filters out all rows.
+	pulseNoMatch = `^$`
+)
+
+type (
+	// PulseStats tracks cluster health counts.
+	pulseStats struct {
+		pods       int
+		phases     map[v1.PodPhase]int
+		dps, dpUp  int
+		dpFilter   string
+		nodes      int
+		nodesReady int
+		noNodes    bool
+		warnRate   float64
+		pvcs       int
+		pvcPending int
+	}
+
+	// PulseSummary tracks a tile content.
+	pulseSummary struct {
+		lines []string
+		trend float64
+		ok    bool
+	}
+
+	// PulseTile represents a dashboard tile for a given resource kind.
+	pulseTile struct {
+		*tview.TextView
+
+		cmd, filter string
+		namespaced  bool
+		history     []float64
+	}
+
+	// PulseView presents a cluster health dashboard.
+	pulseView struct {
+		*tview.Flex
+
+		app      *appView
+		actions  ui.KeyActions
+		current  ui.Igniter
+		cancel   context.CancelFunc
+		ns       string
+		tiles    []*pulseTile
+		selected int
+		mx       sync.Mutex
+		stats    pulseStats
+		polled   time.Time
+	}
+)
+
+// Pod statuses that require attention.
+const podIssues = `Pending|ContainerCreating|PodInitializing|Init:|Failed|Error|CrashLoopBackOff|ImagePull|ErrImage|Unknown|Evicted|Terminating`
+
+func newPulseView(app *appView, ns string) *pulseView {
+	if ns == resource.AllNamespace {
+		ns = resource.AllNamespaces
+	}
+	v := pulseView{
+		Flex: tview.NewFlex(),
+		app:  app,
+		ns:   ns,
+		tiles: []*pulseTile{
+			newPulseTile("Pods", "po", podIssues, true),
+			newPulseTile("Deployments", "dp", pulseNoMatch, true),
+			newPulseTile("Nodes", "no", "NotReady", false),
+			newPulseTile("Events", "ev", "Warning", true),
+			newPulseTile("PVCs", "pvc", "Pending", true),
+		},
+	}
+	v.stats.dpFilter = pulseNoMatch
+	v.current = app.Frame().GetPrimitive("main").(ui.Igniter)
+
+	v.SetDirection(tview.FlexRow)
+	v.SetBorder(true)
+	v.SetBorderPadding(0, 0, 1, 1)
+	v.SetBorderFocusColor(config.AsColor(app.Styles.Frame().Border.FocusColor))
+	v.SetTitle(fmt.Sprintf(pulseTitleFmt, pulseTitle, v.nsName()))
+	for i := 0; i < len(v.tiles); i += pulseCols {
+		row := tview.NewFlex()
+		for j := i; j < i+pulseCols; j++ {
+			if j < len(v.tiles) {
+				row.AddItem(v.tiles[j], 0, 1, false)
+				continue
+			}
+			row.AddItem(tview.NewBox(), 0, 1, false)
+		}
+		v.AddItem(row, 0, 1, false)
+	}
+	v.SetInputCapture(v.keyboard)
+	v.registerActions()
+	v.selectTile(0)
+
+	return &v
+}
+
+func newPulseTile(title, cmd, filter string, namespaced bool) *pulseTile {
+	t := pulseTile{TextView: tview.NewTextView(), cmd: cmd, filter: filter, namespaced: namespaced}
+	t.SetDynamicColors(true)
+	t.SetWrap(false)
+	t.SetBorder(true)
+	t.SetBorderPadding(0, 0, 1, 1)
+	t.SetTitle(" " + title + " ")
+	t.SetTitleAlign(tview.AlignLeft)
+	t.SetTitleColor(tcell.ColorAqua)
+
+	return &t
+}
+
+// Init the view.
+func (v *pulseView) Init(c context.Context, _ string) {
+	ctx, cancel := context.WithCancel(c)
+	v.cancel = cancel
+	go func(ctx context.Context) {
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(time.Duration(v.app.Config.K9s.GetRefreshRate()) * time.Second):
+				v.refresh()
+			}
+		}
+	}(ctx)
+
+	go v.refresh()
+	v.app.SetFocus(v)
+	v.app.SetHints(v.Hints())
+}
+
+// Hints returns the view hints.
+func (v *pulseView) Hints() ui.Hints {
+	return v.actions.Hints()
+}
+
+func (v *pulseView) registerActions() {
+	v.actions = ui.KeyActions{
+		tcell.KeyEscape:  ui.NewKeyAction("Back", v.resetCmd, true),
+		tcell.KeyEnter:   ui.NewKeyAction("Goto", v.enterCmd, true),
+		tcell.KeyTab:     ui.NewKeyAction("Next", v.nextCmd, false),
+		tcell.KeyBacktab: ui.NewKeyAction("Previous", v.prevCmd, false),
+		tcell.KeyRight:   ui.NewKeyAction("Next", v.nextCmd, false),
+		tcell.KeyLeft:    ui.NewKeyAction("Previous", v.prevCmd, false),
+		tcell.KeyDown:    ui.NewKeyAction("Down", v.downCmd, false),
+		tcell.KeyUp:      ui.NewKeyAction("Up", v.upCmd, false),
+		tcell.KeyCtrlR:   ui.NewKeyAction("Refresh", v.refreshCmd, false),
+	}
+}
+
+func (v *pulseView) keyboard(evt *tcell.EventKey) *tcell.EventKey {
+	key := evt.Key()
+	if key == tcell.KeyRune {
+		key = tcell.Key(evt.Rune())
+	}
+	if a, ok := v.actions[key]; ok {
+		log.Debug().Msgf(">> PulseView handled %s", tcell.KeyNames[key])
+		return a.Action(evt)
+	}
+
+	return evt
+}
+
+func (v *pulseView) nsName() string {
+	if v.ns == resource.AllNamespaces {
+		return resource.AllNamespace
+	}
+
+	return v.ns
+}
+
+// Refresh collects the cluster stats and updates the tiles.
+func (v *pulseView) refresh() {
+	s := v.collect()
+	v.app.QueueUpdateDraw(func() {
+		v.tiles[1].filter = s.dpFilter
+		for i, sum := range pulseSummaries(s) {
+			v.tiles[i].update(sum, v.app.Styles.Frame().Status)
+		}
+	})
+}
+
+// Collect gathers pods and nodes stats from the informer. Other resources
+// are listed directly, at most once per poll period.
+func (v *pulseView) collect() pulseStats {
+	v.mx.Lock()
+	defer v.mx.Unlock()
+
+	s := v.stats
+	if pods, err := v.app.informer.List(watch.PodIndex, v.ns, metav1.ListOptions{}); err == nil {
+		s.pods, s.phases = len(pods), podPhases(pods)
+	} else {
+		log.Warn().Err(err).Msg("Pulse pods")
+	}
+	if nodes, err := v.app.informer.List(watch.NodeIndex, "", metav1.ListOptions{}); err == nil {
+		s.nodes, s.nodesReady, s.noNodes = len(nodes), nodesReady(nodes), false
+	} else {
+		s.noNodes = true
+	}
+	if time.Since(v.polled) >= pulsePollRate {
+		v.polled = time.Now()
+		v.poll(&s)
+	}
+	v.stats = s
+
+	return s
+}
+
+func (v *pulseView) poll(s *pulseStats) {
+	dial := v.app.Conn().DialOrDie()
+	if dps, err := dial.AppsV1().Deployments(v.ns).List(metav1.ListOptions{}); err == nil {
+		s.dps, s.dpUp = len(dps.Items), deploymentsUpToDate(dps.Items)
+		s.dpFilter = rollingFilter(dps.Items, v.ns == resource.AllNamespaces)
+	} else {
+		log.Warn().Err(err).Msg("Pulse deployments")
+	}
+	opts := metav1.ListOptions{FieldSelector: fields.OneTermEqualSelector("type", v1.EventTypeWarning).String()}
+	if evs, err := dial.CoreV1().Events(v.ns).List(opts); err == nil {
+		s.warnRate = warningRate(evs.Items, time.Now(), pulseWindow)
+	} else {
+		log.Warn().Err(err).Msg("Pulse events")
+	}
+	if pvcs, err := dial.CoreV1().PersistentVolumeClaims(v.ns).List(metav1.ListOptions{}); err == nil {
+		s.pvcs, s.pvcPending = len(pvcs.Items), pvcsPending(pvcs.Items)
+	} else {
+		log.Warn().Err(err).Msg("Pulse pvcs")
+	}
+}
+
+func (v *pulseView) selectTile(i int) {
+	n := len(v.tiles)
+	v.selected = (i%n + n) % n
+	for j, t := range v.tiles {
+		c := tcell.ColorDodgerBlue
+		if j == v.selected {
+			c = tcell.ColorOrange
+		}
+		t.SetBorderColor(c)
+	}
+}
+
+func (v *pulseView) nextCmd(evt *tcell.EventKey) *tcell.EventKey {
+	v.selectTile(v.selected + 1)
+	return nil
+}
+
+func (v *pulseView) prevCmd(evt *tcell.EventKey) *tcell.EventKey {
+	v.selectTile(v.selected - 1)
+	return nil
+}
+
+func (v *pulseView) downCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if v.selected+pulseCols < len(v.tiles) {
+		v.selectTile(v.selected + pulseCols)
+	}
+	return nil
+}
+
+func (v *pulseView) upCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if v.selected-pulseCols >= 0 {
+		v.selectTile(v.selected - pulseCols)
+	}
+	return nil
+}
+
+func (v *pulseView) refreshCmd(evt *tcell.EventKey) *tcell.EventKey {
+	v.mx.Lock()
+	v.polled = time.Time{}
+	v.mx.Unlock()
+	go v.refresh()
+	return nil
+}
+
+func (v *pulseView) enterCmd(evt *tcell.EventKey) *tcell.EventKey {
+	t := v.tiles[v.selected]
+	cmd := t.cmd
+	if t.namespaced {
+		cmd += " " + v.nsName()
+	}
+	gotoFiltered(v.app, cmd, t.filter)
+
+	return nil
+}
+
+func (v *pulseView) resetCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if v.cancel != nil {
+		v.cancel()
+	}
+	v.app.inject(v.current)
+
+	return nil
+}
+
+func (t *pulseTile) update(s pulseSummary, st config.Status) {
+	t.history = append(t.history, s.trend)
+	if len(t.history) > pulseTrendSize {
+		t.history = t.history[len(t.history)-pulseTrendSize:]
+	}
+
+	c := st.NewColor
+	if !s.ok {
+		c = st.ErrorColor
+	}
+	lines := make([]string, 0, len(s.lines)+2)
+	lines = append(lines, fmt.Sprintf("[%s::b]%s[-::-]", c, s.lines[0]))
+	lines = append(lines, s.lines[1:]...)
+	lines = append(lines, "", fmt.Sprintf("[%s::]%s[-::]", st.HighlightColor, sparkline(t.history)))
+	t.SetText(strings.Join(lines, "\n"))
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// PulseSummaries renders the stats for each tile, in tile order.
+func pulseSummaries(s pulseStats) []pulseSummary {
+	running := s.phases[v1.PodRunning]
+	pods := pulseSummary{
+		lines: []string{fmt.Sprintf("%d/%d running", running, s.pods)},
+		trend: float64(running),
+		ok:    running+s.phases[v1.PodSucceeded] == s.pods,
+	}
+	pp := make([]string, 0, len(s.phases))
+	for p := range s.phases {
+		pp = append(pp, string(p))
+	}
+	sort.Strings(pp)
+	for _, p := range pp {
+		pods.lines = append(pods.lines, fmt.Sprintf("%-10s %d", p, s.phases[v1.PodPhase(p)]))
+	}
+
+	dps := pulseSummary{
+		lines: []string{
+			fmt.Sprintf("%d/%d up to date", s.dpUp, s.dps),
+			fmt.Sprintf("%-10s %d", "Rolling", s.dps-s.dpUp),
+		},
+		trend: float64(s.dpUp),
+		ok:    s.dpUp == s.dps,
+	}
+
+	nodes := pulseSummary{
+		lines: []string{
+			fmt.Sprintf("%d/%d ready", s.nodesReady, s.nodes),
+			fmt.Sprintf("%-10s %d", "NotReady", s.nodes-s.nodesReady),
+		},
+		trend: float64(s.nodesReady),
+		ok:    s.nodesReady == s.nodes,
+	}
+	if s.noNodes {
+		nodes.lines, nodes.ok = []string{resource.NAValue, "no node access"}, true
+	}
+
+	evs := pulseSummary{
+		lines: []string{
+			fmt.Sprintf("%.1f warnings/min", s.warnRate),
+			fmt.Sprintf("over the last %s", pulseWindow),
+		},
+		trend: s.warnRate,
+		ok:    s.warnRate == 0,
+	}
+
+	pvcs := pulseSummary{
+		lines: []string{
+			fmt.Sprintf("%d pending", s.pvcPending),
+			fmt.Sprintf("%-10s %d", "Total", s.pvcs),
+		},
+		trend: float64(s.pvcPending),
+		ok:    s.pvcPending == 0,
+	}
+
+	return []pulseSummary{pods, dps, nodes, evs, pvcs}
+}
+
+func podPhases(pods k8s.Collection) map[v1.PodPhase]int {
+	pp := make(map[v1.PodPhase]int)
+	for _, o := range pods {
+		if po, ok := o.(*v1.Pod); ok {
+			pp[po.Status.Phase]++
+		}
+	}
+
+	return pp
+}
+
+func nodesReady(nodes k8s.Collection) int {
+	var count int
+	for _, o := range nodes {
+		no, ok := o.(*v1.Node)
+		if !ok {
+			continue
+		}
+		for _, c := range no.Status.Conditions {
+			if c.Type == v1.NodeReady && c.Status == v1.ConditionTrue {
+				count++
+				break
+			}
+		}
+	}
+
+	return count
+}
+
+func deploymentsUpToDate(dps []appsv1.Deployment) int {
+	var count int
+	for _, dp := range dps {
+		if upToDate(dp) {
+			count++
+		}
+	}
+
+	return count
+}
+
+// RollingFilter matches the deployments rows that are not up to date.
+func rollingFilter(dps []appsv1.Deployment, allNS bool) string {
+	var nn []string
+	for _, dp := range dps {
+		if upToDate(dp) {
+			continue
+		}
+		n := dp.Name
+		if allNS {
+			n = dp.Namespace + " " + n
+		}
+		nn = append(nn, regexp.QuoteMeta(n))
+	}
+	if len(nn) == 0 {
+		return pulseNoMatch
+	}
+	sort.Strings(nn)
+
+	return `^(` + strings.Join(nn, "|") + `) `
+}
+
+func upToDate(dp appsv1.Deployment) bool {
+	desired := int32(1)
+	if dp.Spec.Replicas != nil {
+		desired = *dp.Spec.Replicas
+	}
+	s := dp.Status
+
+	return s.ObservedGeneration >= dp.Generation && s.UpdatedReplicas == desired && s.AvailableReplicas == desired
+}
+
+func warningRate(evs []v1.Event, now time.Time, window time.Duration) float64 {
+	var count int
+	since := now.Add(-window)
+	for _, e := range evs {
+		if e.Type != v1.EventTypeWarning {
+			continue
+		}
+		last := e.LastTimestamp.Time
+		if last.IsZero() {
+			last = e.EventTime.Time
+		}
+		if !last.Before(since) {
+			count++
+		}
+	}
+
+	return float64(count) / window.Minutes()
+}
+
+func pvcsPending(pvcs []v1.PersistentVolumeClaim) int {
+	var count int
+	for _, pvc := range pvcs {
+		if pvc.Status.Phase == v1.ClaimPending {
+			count++
+		}
+	}
+
+	return count
+}
@@ -0,0 +1,160 @@
+package views
+
+import (
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/k8s"
+	"github.com/stretchr/testify/assert"
+	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestPodPhases(t *testing.T) {
+	pods := k8s.Collection{
+		&v1.Pod{Status: v1.PodStatus{Phase: v1.PodRunning}},
+		&v1.Pod{Status: v1.PodStatus{Phase: v1.PodRunning}},
+		&v1.Pod{Status: v1.PodStatus{Phase: v1.PodPending}},
+	}
+
+	assert.Equal(t, map[v1.PodPhase]int{v1.PodRunning: 2, v1.PodPending: 1}, podPhases(pods))
+}
+
+func TestNodesReady(t *testing.T) {
+	node := func(s v1.ConditionStatus) *v1.Node {
+		return &v1.Node{Status: v1.NodeStatus{Conditions: []v1.NodeCondition{
+			{Type: v1.NodeMemoryPressure, Status: v1.ConditionTrue},
+			{Type: v1.NodeReady, Status: s},
+		}}}
+	}
+
+	assert.Equal(t, 2, nodesReady(k8s.Collection{
+		node(v1.ConditionTrue),
+		node(v1.ConditionFalse),
+		node(v1.ConditionTrue),
+		&v1.Node{},
+	}))
+}
+
+func TestDeploymentsUpToDate(t *testing.T) {
+	two := int32(2)
+	uu := map[string]struct {
+		dp appsv1.Deployment
+		e  int
+	}{
+		"upToDate": {
+			dp: appsv1.Deployment{
+				Spec:   appsv1.DeploymentSpec{Replicas: &two},
+				Status: appsv1.DeploymentStatus{UpdatedReplicas: 2, AvailableReplicas: 2},
+			},
+			e: 1,
+		},
+		"defaultReplicas": {
+			dp: appsv1.Deployment{Status: appsv1.DeploymentStatus{UpdatedReplicas: 1, AvailableReplicas: 1}},
+			e:  1,
+		},
+		"rolling": {
+			dp: appsv1.Deployment{
+				Spec:   appsv1.DeploymentSpec{Replicas: &two},
+				Status: appsv1.DeploymentStatus{UpdatedReplicas: 1, AvailableReplicas: 2},
+			},
+		},
+		"notObserved": {
+			dp: appsv1.Deployment{
+				ObjectMeta: metav1.ObjectMeta{Generation: 2},
+				Spec:       appsv1.DeploymentSpec{Replicas: &two},
+				Status:     appsv1.DeploymentStatus{ObservedGeneration: 1, UpdatedReplicas: 2, AvailableReplicas: 2},
+			},
+		},
+	}
+
+	for k, u := range uu {
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, deploymentsUpToDate([]appsv1.Deployment{u.dp}))
+		})
+	}
+}
+
+func TestRollingFilter(t *testing.T) {
+	two := int32(2)
+	dp := func(ns, n string, updated int32) appsv1.Deployment {
+		return appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{Namespace: ns, Name: n},
+			Spec:       appsv1.DeploymentSpec{Replicas: &two},
+			Status:     appsv1.DeploymentStatus{UpdatedReplicas: updated, AvailableReplicas: 2},
+		}
+	}
+	dps := []appsv1.Deployment{dp("ns1", "fred", 2), dp("ns1", "blee.v2", 1), dp("ns2", "zorg", 0)}
+
+	uu := map[string]struct {
+		dps   []appsv1.Deployment
+		allNS bool
+		e     string
+	}{
+		"none":  {dps[:1], false, pulseNoMatch},
+		"ns":    {dps, false, `^(blee\.v2|zorg) `},
+		"allNS": {dps, true, `^(ns1 blee\.v2|ns2 zorg) `},
+	}
+
+	for k, u := range uu {
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, rollingFilter(u.dps, u.allNS))
+		})
+	}
+}
+
+func TestWarningRate(t *testing.T) {
+	now := time.Now()
+	ev := func(kind string, ago time.Duration) v1.Event {
+		return v1.Event{Type: kind, LastTimestamp: metav1.NewTime(now.Add(-ago))}
+	}
+	evs := []v1.Event{
+		ev(v1.EventTypeWarning, time.Minute),
+		ev(v1.EventTypeWarning, 2*time.Minute),
+		ev(v1.EventTypeNormal, time.Minute),
+		ev(v1.EventTypeWarning, 10*time.Minute),
+		{Type: v1.EventTypeWarning, EventTime: metav1.NewMicroTime(now)},
+	}
+
+	assert.Equal(t, 0.6, warningRate(evs, now, 5*time.Minute))
+	assert.Equal(t, 0.0, warningRate(nil, now, 5*time.Minute))
+}
+
+func TestPVCsPending(t *testing.T) {
+	pvcs := []v1.PersistentVolumeClaim{
+		{Status: v1.PersistentVolumeClaimStatus{Phase: v1.ClaimBound}},
+		{Status: v1.PersistentVolumeClaimStatus{Phase: v1.ClaimPending}},
+	}
+
+	assert.Equal(t, 1, pvcsPending(pvcs))
+}
+
+func TestPulseSummaries(t *testing.T) {
+	s := pulseStats{
+		pods:       3,
+		phases:     map[v1.PodPhase]int{v1.PodRunning: 2, v1.PodPending: 1},
+		dps:        2,
+		dpUp:       2,
+		nodes:      3,
+		nodesReady: 2,
+		warnRate:   1.5,
+		pvcs:       4,
+	}
+	ss := pulseSummaries(s)
+
+	assert.Equal(t, 5, len(ss))
+	assert.Equal(t, []string{"2/3 running", "Pending    1", "Running    2"}, ss[0].lines)
+	assert.False(t, ss[0].ok)
+	assert.Equal(t, 2.0, ss[0].trend)
+	assert.True(t, ss[1].ok)
+	assert.Equal(t, "2/3 ready", ss[2].lines[0])
+	assert.False(t, ss[2].ok)
+	assert.Equal(t, "1.5 warnings/min", ss[3].lines[0])
+	assert.False(t, ss[3].ok)
+	assert.True(t, ss[4].ok)
+
+	ss = pulseSummaries(pulseStats{noNodes: true})
+	assert.True(t, ss[2].ok)
+	assert.Equal(t, "no node access", ss[2].lines[1])
+}
@@ -41,6 +41,9 @@ func listFunc(l resource.List) viewFn {
 
 var aliases = config.NewAliases()
 
+// Custom resources are served with all the standard verbs.
+var crdVerbs = metav1.Verbs{"create", "delete", "deletecollection", "get", "list", "patch", "update", "watch"}
+
 func allCRDs(c k8s.Connection, vv viewers) {
 	crds, err := resource.NewCustomResourceDefinitionList(c, resource.AllNamespaces).
 		Resource().
@@ -71,10 +74,12 @@ func allCRDs(c k8s.Connection, vv viewers) {
 		}
 
 		vv[gvrs] = viewer{
-			gvr:       gvrs,
-			kind:      meta.Kind,
-			viewFn:    listFunc(resource.NewCustomList(c, meta.Namespaced, "", gvrs)),
-			colorerFn: ui.DefaultColorer,
+			gvr:        gvrs,
+			kind:       meta.Kind,
+			namespaced: meta.Namespaced,
+			verbs:      crdVerbs,
+			viewFn:     listFunc(resource.NewCustomList(c, meta.Namespaced, "", gvrs)),
+			colorerFn:  ui.DefaultColorer,
 		}
 	}
 	log.Debug().Msgf("Loading CRDS %v", time.Since(t))
@@ -0,0 +1,361 @@
+package views
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/derailed/k9s/internal/k8s"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tview"
+	"github.com/gdamore/tcell"
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/api/extensions/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+const relatedTitleFmt = " [aqua::b]Related([fuchsia::b]%s[aqua::-]) "
+
+// Kinds that are not namespaced.
+var clusterKinds = map[string]bool{"Node": true, "PersistentVolume": true, "StorageClass": true}
+
+type (
+	// RelatedRef represents resources related to a selection.
+	relatedRef struct {
+		kind     string
+		ns       string
+		names    []string
+		labelSel string
+	}
+
+	// RelatedFn resolves the resources related to a given resource.
+	relatedFn func(c k8s.Connection, ns, n string) ([]relatedRef, error)
+
+	// RelatedList presents a related resources picker.
+	relatedList struct {
+		*tview.List
+
+		actions ui.KeyActions
+	}
+)
+
+var relatedResolvers = map[string]relatedFn{
+	"v1/pods":                                      podRelatedFn,
+	"v1/persistentvolumeclaims":                    pvcRelatedFn,
+	"v1/persistentvolumes":                         pvRelatedFn,
+	"v1/services":                                  svcRelatedFn,
+	"v1/endpoints":                                 epRelatedFn,
+	"extensions/v1beta1/ingresses":                 ingRelatedFn,
+	"autoscaling/v1/horizontalpodautoscalers":      hpaRelatedFn,
+	"autoscaling/v2beta1/horizontalpodautoscalers": hpaRelatedFn,
+	"autoscaling/v2beta2/horizontalpodautoscalers": hpaRelatedFn,
+}
+
+func newRelatedList(backFn ui.ActionHandler) *relatedList {
+	v := relatedList{
+		List: tview.NewList(),
+		actions: ui.KeyActions{
+			tcell.KeyEscape: ui.NewKeyAction("Back", backFn, true),
+		},
+	}
+	v.SetBorder(true)
+	v.SetMainTextColor(tcell.ColorWhite)
+	v.ShowSecondaryText(false)
+	v.SetShortcutColor(tcell.ColorAqua)
+	v.SetSelectedBackgroundColor(tcell.ColorAqua)
+	v.SetInputCapture(func(evt *tcell.EventKey) *tcell.EventKey {
+		if a, ok := v.actions[evt.Key()]; ok {
+			a.Action(evt)
+			evt = nil
+		}
+		return evt
+	})
+
+	return &v
+}
+
+// Hints returns the picker hints.
+func (v *relatedList) Hints() ui.Hints {
+	return v.actions.Hints()
+}
+
+func (v *relatedList) populate(rr []relatedRef, selectFn func(relatedRef)) {
+	v.Clear()
+	for i, r := range rr {
+		r := r
+		v.AddItem(r.label(), "", rune('a'+i), func() {
+			selectFn(r)
+		})
+	}
+}
+
+func (v *resourceView) relatedCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if !v.masterPage().RowSelected() {
+		return evt
+	}
+
+	sel := v.masterPage().GetSelectedItem()
+	ns, n := namespaced(sel)
+	rr, err := relatedResolvers[v.gvr](v.app.Conn(), ns, n)
+	if err != nil {
+		v.app.Flash().Errf("Unable to resolve related resources %s", err)
+		return nil
+	}
+	if len(rr) == 0 {
+		v.app.Flash().Infof("No related resources found for %s", sel)
+		return nil
+	}
+
+	l, ok := v.GetPrimitive("related").(*relatedList)
+	if !ok {
+		l = newRelatedList(v.backCmd)
+		v.AddPage("related", l, true, false)
+	}
+	l.SetTitle(fmt.Sprintf(relatedTitleFmt, sel))
+	l.populate(rr, func(r relatedRef) {
+		v.switchPage("master")
+		gotoRelated(v.app, r)
+	})
+	v.switchPage("related")
+
+	return nil
+}
+
+// GotoRelated shows the related resources view.
+func gotoRelated(app *appView, r relatedRef) {
+	if r.labelSel == "" {
+		gotoFiltered(app, r.cmd(), relatedFilter(r.names))
+		return
+	}
+
+	current := app.Frame().GetPrimitive("main").(ui.Igniter)
+	showPods(app, r.ns, r.labelSel, "", func(*tcell.EventKey) *tcell.EventKey {
+		app.inject(current)
+		return nil
+	})
+}
+
+// GotoFiltered runs a command and filters the resulting table view.
+func gotoFiltered(app *appView, cmd, filter string) bool {
+	if !app.gotoResource(cmd, true) {
+		return false
+	}
+	if filter == "" {
+		return true
+	}
+	if r, ok := app.Frame().GetPrimitive("main").(resourceViewer); ok {
+		tv := r.masterPage()
+		tv.SearchBuff().Set(filter)
+		tv.Refresh()
+	}
+
+	return true
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func (r relatedRef) cmd() string {
+	cmd := strings.ToLower(r.kind)
+	if r.ns == "" || clusterKinds[r.kind] {
+		return cmd
+	}
+
+	return cmd + " " + r.ns
+}
+
+func (r relatedRef) label() string {
+	if r.labelSel != "" {
+		return fmt.Sprintf("%-22s %s", r.kind, r.labelSel)
+	}
+
+	return fmt.Sprintf("%-22s %s", r.kind, strings.Join(r.names, ", "))
+}
+
+// RelatedFilter matches table rows with the given resource names.
+func relatedFilter(nn []string) string {
+	if len(nn) == 0 {
+		return ""
+	}
+	qq := make([]string, 0, len(nn))
+	for _, n := range nn {
+		qq = append(qq, regexp.QuoteMeta(n))
+	}
+
+	return `(^|\s)(` + strings.Join(qq, "|") + `)(\s|$)`
+}
+
+func podRelatedFn(c k8s.Connection, ns, n string) ([]relatedRef, error) {
+	o, err := k8s.NewPod(c).Get(ns, n)
+	if err != nil {
+		return nil, err
+	}
+
+	return podRelated(o.(*v1.Pod)), nil
+}
+
+func podRelated(po *v1.Pod) []relatedRef {
+	var rr []relatedRef
+	if po.Spec.NodeName != "" {
+		rr = append(rr, relatedRef{kind: "Node", names: []string{po.Spec.NodeName}})
+	}
+	if ref := metav1.GetControllerOf(po); ref != nil {
+		rr = append(rr, relatedRef{kind: ref.Kind, ns: po.Namespace, names: []string{ref.Name}})
+	}
+	for _, ref := range k8s.PodRefs(*po) {
+		rr = append(rr, relatedRef{kind: ref.Kind, ns: po.Namespace, names: []string{ref.Name}})
+	}
+
+	return rr
+}
+
+func pvcRelatedFn(c k8s.Connection, ns, n string) ([]relatedRef, error) {
+	o, err := k8s.NewPersistentVolumeClaim(c).Get(ns, n)
+	if err != nil {
+		return nil, err
+	}
+
+	return pvcRelated(o.(*v1.PersistentVolumeClaim)), nil
+}
+
+func pvcRelated(pvc *v1.PersistentVolumeClaim) []relatedRef {
+	var rr []relatedRef
+	if pvc.Spec.VolumeName != "" {
+		rr = append(rr, relatedRef{kind: "PersistentVolume", names: []string{pvc.Spec.VolumeName}})
+	}
+	if sc := pvc.Spec.StorageClassName; sc != nil && *sc != "" {
+		rr = append(rr, relatedRef{kind: "StorageClass", names: []string{*sc}})
+	}
+
+	return rr
+}
+
+func pvRelatedFn(c k8s.Connection, _, n string) ([]relatedRef, error) {
+	o, err := k8s.NewPersistentVolume(c).Get("", n)
+	if err != nil {
+		return nil, err
+	}
+
+	return pvRelated(o.(*v1.PersistentVolume)), nil
+}
+
+func pvRelated(pv *v1.PersistentVolume) []relatedRef {
+	var rr []relatedRef
+	if ref := pv.Spec.ClaimRef; ref != nil {
+		rr = append(rr, relatedRef{kind: "PersistentVolumeClaim", ns: ref.Namespace, names: []string{ref.Name}})
+	}
+	if pv.Spec.StorageClassName != "" {
+		rr = append(rr, relatedRef{kind: "StorageClass", names: []string{pv.Spec.StorageClassName}})
+	}
+
+	return rr
+}
+
+func svcRelatedFn(c k8s.Connection, ns, n string) ([]relatedRef, error) {
+	o, err := k8s.NewService(c).Get(ns, n)
+	if err != nil {
+		return nil, err
+	}
+
+	return svcRelated(o.(*v1.Service)), nil
+}
+
+func svcRelated(svc *v1.Service) []relatedRef {
+	rr := []relatedRef{{kind: "Endpoints", ns: svc.Namespace, names: []string{svc.Name}}}
+	if len(svc.Spec.Selector) > 0 {
+		rr = append(rr, relatedRef{
+			kind:     "Pod",
+			ns:       svc.Namespace,
+			labelSel: labels.SelectorFromSet(svc.Spec.Selector).String(),
+		})
+	}
+
+	return rr
+}
+
+func epRelatedFn(c k8s.Connection, ns, n string) ([]relatedRef, error) {
+	o, err := k8s.NewEndpoints(c).Get(ns, n)
+	if err != nil {
+		return nil, err
+	}
+
+	return epRelated(o.(*v1.Endpoints)), nil
+}
+
+func epRelated(ep *v1.Endpoints) []relatedRef {
+	var (
+		pods []string
+		seen = make(map[string]bool)
+	)
+	add := func(aa []v1.EndpointAddress) {
+		for _, a := range aa {
+			if a.TargetRef == nil || a.TargetRef.Kind != "Pod" || seen[a.TargetRef.Name] {
+				continue
+			}
+			seen[a.TargetRef.Name] = true
+			pods = append(pods, a.TargetRef.Name)
+		}
+	}
+	for _, s := range ep.Subsets {
+		add(s.Addresses)
+		add(s.NotReadyAddresses)
+	}
+
+	rr := []relatedRef{{kind: "Service", ns: ep.Namespace, names: []string{ep.Name}}}
+	if len(pods) > 0 {
+		rr = append(rr, relatedRef{kind: "Pod", ns: ep.Namespace, names: pods})
+	}
+
+	return rr
+}
+
+func ingRelatedFn(c k8s.Connection, ns, n string) ([]relatedRef, error) {
+	o, err := k8s.NewIngress(c).Get(ns, n)
+	if err != nil {
+		return nil, err
+	}
+
+	return ingRelated(o.(*v1beta1.Ingress)), nil
+}
+
+func ingRelated(ing *v1beta1.Ingress) []relatedRef {
+	var (
+		rr   []relatedRef
+		seen = make(map[string]bool)
+	)
+	add := func(b *v1beta1.IngressBackend) {
+		if b == nil || b.ServiceName == "" || seen[b.ServiceName] {
+			return
+		}
+		seen[b.ServiceName] = true
+		rr = append(rr, relatedRef{kind: "Service", ns: ing.Namespace, names: []string{b.ServiceName}})
+	}
+	add(ing.Spec.Backend)
+	for _, r := range ing.Spec.Rules {
+		if r.HTTP == nil {
+			continue
+		}
+		for _, p := range r.HTTP.Paths {
+			add(&p.Backend)
+		}
+	}
+
+	return rr
+}
+
+func hpaRelatedFn(c k8s.Connection, ns, n string) ([]relatedRef, error) {
+	o, err := k8s.NewHorizontalPodAutoscalerV1(c).Get(ns, n)
+	if err != nil {
+		return nil, err
+	}
+
+	return hpaRelated(o.(*autoscalingv1.HorizontalPodAutoscaler)), nil
+}
+
+func hpaRelated(hpa *autoscalingv1.HorizontalPodAutoscaler) []relatedRef {
+	ref := hpa.Spec.ScaleTargetRef
+
+	return []relatedRef{{kind: ref.Kind, ns: hpa.Namespace, names: []string{ref.Name}}}
+}
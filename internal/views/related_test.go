@@ -0,0 +1,157 @@
+package views
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/api/extensions/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+func TestRelatedFilter(t *testing.T) {
+	rx := regexp.MustCompile(relatedFilter([]string{"fred.1", "blee"}))
+
+	assert.True(t, rx.MatchString("default fred.1 Running"))
+	assert.True(t, rx.MatchString("blee"))
+	assert.False(t, rx.MatchString("default fred.10 Running"))
+	assert.False(t, rx.MatchString("default fredx1 Running"))
+	assert.Equal(t, "", relatedFilter(nil))
+}
+
+func TestRelatedRefCmd(t *testing.T) {
+	uu := map[string]struct {
+		r relatedRef
+		e string
+	}{
+		"namespaced": {relatedRef{kind: "ReplicaSet", ns: "default"}, "replicaset default"},
+		"cluster":    {relatedRef{kind: "Node", ns: "default"}, "node"},
+		"noNS":       {relatedRef{kind: "StorageClass"}, "storageclass"},
+	}
+
+	for k, u := range uu {
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, u.r.cmd())
+		})
+	}
+}
+
+func TestPodRelated(t *testing.T) {
+	ctrl := true
+	po := v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "default",
+			Name:      "p1",
+			OwnerReferences: []metav1.OwnerReference{
+				{Kind: "ReplicaSet", Name: "rs1", Controller: &ctrl},
+			},
+		},
+		Spec: v1.PodSpec{
+			NodeName: "n1",
+			Volumes: []v1.Volume{
+				{Name: "data", VolumeSource: v1.VolumeSource{PersistentVolumeClaim: &v1.PersistentVolumeClaimVolumeSource{ClaimName: "pvc1"}}},
+			},
+		},
+	}
+
+	assert.Equal(t, []relatedRef{
+		{kind: "Node", names: []string{"n1"}},
+		{kind: "ReplicaSet", ns: "default", names: []string{"rs1"}},
+		{kind: "ServiceAccount", ns: "default", names: []string{"default"}},
+		{kind: "PersistentVolumeClaim", ns: "default", names: []string{"pvc1"}},
+	}, podRelated(&po))
+}
+
+func TestPVCRelated(t *testing.T) {
+	sc := "standard"
+	pvc := v1.PersistentVolumeClaim{Spec: v1.PersistentVolumeClaimSpec{VolumeName: "pv1", StorageClassName: &sc}}
+
+	assert.Equal(t, []relatedRef{
+		{kind: "PersistentVolume", names: []string{"pv1"}},
+		{kind: "StorageClass", names: []string{"standard"}},
+	}, pvcRelated(&pvc))
+	assert.Nil(t, pvcRelated(&v1.PersistentVolumeClaim{}))
+}
+
+func TestPVRelated(t *testing.T) {
+	pv := v1.PersistentVolume{Spec: v1.PersistentVolumeSpec{
+		ClaimRef:         &v1.ObjectReference{Namespace: "default", Name: "pvc1"},
+		StorageClassName: "standard",
+	}}
+
+	assert.Equal(t, []relatedRef{
+		{kind: "PersistentVolumeClaim", ns: "default", names: []string{"pvc1"}},
+		{kind: "StorageClass", names: []string{"standard"}},
+	}, pvRelated(&pv))
+}
+
+func TestSvcRelated(t *testing.T) {
+	svc := v1.Service{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "s1"},
+		Spec:       v1.ServiceSpec{Selector: map[string]string{"app": "fred", "tier": "web"}},
+	}
+
+	assert.Equal(t, []relatedRef{
+		{kind: "Endpoints", ns: "default", names: []string{"s1"}},
+		{kind: "Pod", ns: "default", labelSel: "app=fred,tier=web"},
+	}, svcRelated(&svc))
+
+	svc.Spec.Selector = nil
+	assert.Equal(t, 1, len(svcRelated(&svc)))
+}
+
+func TestEpRelated(t *testing.T) {
+	pod := func(n string) v1.EndpointAddress {
+		return v1.EndpointAddress{TargetRef: &v1.ObjectReference{Kind: "Pod", Name: n}}
+	}
+	ep := v1.Endpoints{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "s1"},
+		Subsets: []v1.EndpointSubset{
+			{Addresses: []v1.EndpointAddress{pod("p1"), pod("p2"), {IP: "10.0.0.1"}}},
+			{Addresses: []v1.EndpointAddress{pod("p1")}, NotReadyAddresses: []v1.EndpointAddress{pod("p3")}},
+		},
+	}
+
+	assert.Equal(t, []relatedRef{
+		{kind: "Service", ns: "default", names: []string{"s1"}},
+		{kind: "Pod", ns: "default", names: []string{"p1", "p2", "p3"}},
+	}, epRelated(&ep))
+}
+
+func TestIngRelated(t *testing.T) {
+	backend := func(s string) v1beta1.IngressBackend {
+		return v1beta1.IngressBackend{ServiceName: s, ServicePort: intstr.FromInt(80)}
+	}
+	b := backend("s0")
+	ing := v1beta1.Ingress{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "i1"},
+		Spec: v1beta1.IngressSpec{
+			Backend: &b,
+			Rules: []v1beta1.IngressRule{
+				{IngressRuleValue: v1beta1.IngressRuleValue{HTTP: &v1beta1.HTTPIngressRuleValue{
+					Paths: []v1beta1.HTTPIngressPath{{Backend: backend("s1")}, {Backend: backend("s0")}},
+				}}},
+				{Host: "blee"},
+			},
+		},
+	}
+
+	assert.Equal(t, []relatedRef{
+		{kind: "Service", ns: "default", names: []string{"s0"}},
+		{kind: "Service", ns: "default", names: []string{"s1"}},
+	}, ingRelated(&ing))
+}
+
+func TestHPARelated(t *testing.T) {
+	hpa := autoscalingv1.HorizontalPodAutoscaler{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "h1"},
+		Spec: autoscalingv1.HorizontalPodAutoscalerSpec{
+			ScaleTargetRef: autoscalingv1.CrossVersionObjectReference{Kind: "Deployment", Name: "dp1"},
+		},
+	}
+
+	assert.Equal(t, []relatedRef{{kind: "Deployment", ns: "default", names: []string{"dp1"}}}, hpaRelated(&hpa))
+}
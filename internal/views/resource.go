@@ -59,6 +59,9 @@ func (v *resourceView) Init(ctx context.Context, ns string) {
 	if v.colorerFn != nil {
 		v.masterPage().SetColorerFn(v.colorerFn)
 	}
+	if v.list.Access(resource.ViewAccess) {
+		v.masterPage().setObjectFn(v.list.Resource().Marshal)
+	}
 
 	v.parentCtx = ctx
 	var vctx context.Context
@@ -133,7 +136,7 @@ func (v *resourceView) backCmd(*tcell.EventKey) *tcell.EventKey {
 
 func (v *resourceView) switchPage(p string) {
 	log.Debug().Msgf("Switching page to %s", p)
-	if _, ok := v.CurrentPage().Item.(*tableView); ok {
+	if v.liveUpdates() {
 		v.stopUpdates()
 	}
 
@@ -143,11 +146,21 @@ func (v *resourceView) switchPage(p string) {
 		v.app.SetHints(vu.Hints())
 	}
 
-	if _, ok := v.CurrentPage().Item.(*tableView); ok {
+	if v.liveUpdates() {
 		v.restartUpdates()
 	}
 }
 
+// LiveUpdates checks if the current page tracks the list updates.
+func (v *resourceView) liveUpdates() bool {
+	switch v.CurrentPage().Item.(type) {
+	case *tableView, *rolloutView:
+		return true
+	default:
+		return false
+	}
+}
+
 // ----------------------------------------------------------------------------
 // Actions...
 
@@ -233,8 +246,7 @@ func (v *resourceView) markCmd(evt *tcell.EventKey) *tcell.EventKey {
 
 func deletePortForward(ff map[string]forwarder, sel string) {
 	for k, v := range ff {
-		tokens := strings.Split(k, ":")
-		if tokens[0] == sel {
+		if v.Path() == sel && v.Selector() == "" {
 			log.Debug().Msgf("Deleting associated portForward %s", k)
 			v.Stop()
 		}
@@ -351,6 +363,10 @@ func (v *resourceView) switchNamespaceCmd(evt *tcell.EventKey) *tcell.EventKey {
 }
 
 func (v *resourceView) refresh() {
+	if rv, ok := v.CurrentPage().Item.(*rolloutView); ok {
+		v.refreshRollout(rv)
+		return
+	}
 	if _, ok := v.CurrentPage().Item.(*tableView); !ok {
 		return
 	}
@@ -413,6 +429,9 @@ func (v *resourceView) refreshActions() {
 	if v.list.Access(resource.DescribeAccess) {
 		aa[ui.KeyD] = ui.NewKeyAction("Describe", v.describeCmd, true)
 	}
+	if _, ok := relatedResolvers[v.gvr]; ok {
+		aa[ui.KeyG] = ui.NewKeyAction("Related", v.relatedCmd, true)
+	}
 	v.customActions(aa)
 
 	t := v.masterPage()
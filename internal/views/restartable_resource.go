@@ -2,7 +2,10 @@ package views
 
 import (
 	"errors"
+	"fmt"
+	"strings"
 
+	"github.com/derailed/k9s/internal/k8s"
 	"github.com/derailed/k9s/internal/resource"
 	"github.com/derailed/k9s/internal/ui"
 	"github.com/derailed/k9s/internal/ui/dialog"
@@ -25,6 +28,15 @@ func newRestartableResourceViewForParent(parent *resourceView) *restartableResou
 
 func (v *restartableResourceView) extraActions(aa ui.KeyActions) {
 	aa[tcell.KeyCtrlT] = ui.NewKeyAction("Restart Rollout", v.restartCmd, true)
+	if _, ok := v.list.Resource().(resource.Rollbackable); ok {
+		aa[ui.KeyO] = ui.NewKeyAction("Rollout History", v.historyCmd, true)
+	}
+	if _, ok := v.list.Resource().(resource.Pausable); ok {
+		aa[ui.KeyZ] = ui.NewKeyAction("Pause/Resume", v.pauseCmd, true)
+	}
+	if _, ok := v.list.Resource().(resource.Trackable); ok {
+		aa[ui.KeyW] = ui.NewKeyAction("Rollout Status", v.rolloutCmd, true)
+	}
 }
 
 func (v *restartableResourceView) restartCmd(evt *tcell.EventKey) *tcell.EventKey {
@@ -39,11 +51,16 @@ func (v *restartableResourceView) restartCmd(evt *tcell.EventKey) *tcell.EventKe
 	dialog.ShowConfirm(v.Pages, "<Confirm Restart>", msg, func() {
 		if err := v.restartRollout(sel); err != nil {
 			v.app.Flash().Err(err)
-		} else {
-			v.app.Flash().Infof("Rollout restart in progress for `%s...", sel)
+			return
+		}
+		v.app.Flash().Infof("Rollout restart in progress for `%s...", sel)
+		if _, ok := v.list.Resource().(resource.Trackable); ok {
+			v.showRollout(sel)
 		}
 	}, func() {
-		v.showMaster()
+		if name, _ := v.GetFrontPage(); name != rolloutPage {
+			v.showMaster()
+		}
 	})
 
 	return nil
@@ -58,3 +75,169 @@ func (v *restartableResourceView) restartRollout(selection string) error {
 
 	return r.Restart(ns, n)
 }
+
+func (v *restartableResourceView) historyCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if !v.masterPage().RowSelected() {
+		return evt
+	}
+
+	sel := v.masterPage().GetSelectedItem()
+	r, ok := v.list.Resource().(resource.Rollbackable)
+	if !ok {
+		v.app.Flash().Err(errors.New("resource is not of type resource.Rollbackable"))
+		return nil
+	}
+	ns, n := namespaced(sel)
+	rr, err := r.History(ns, n)
+	if err != nil {
+		v.app.Flash().Errf("Unable to fetch rollout history %s", err)
+		return nil
+	}
+	if len(rr) == 0 {
+		v.app.Flash().Infof("No rollout history found for %s", sel)
+		return nil
+	}
+
+	h := v.historyPage()
+	h.update(sel, rr)
+	v.switchPage(historyPage)
+
+	return nil
+}
+
+func (v *restartableResourceView) historyPage() *historyView {
+	if h, ok := v.GetPrimitive(historyPage).(*historyView); ok {
+		return h
+	}
+
+	h := newHistoryView(v.app)
+	h.SetActions(ui.KeyActions{
+		tcell.KeyEscape: ui.NewKeyAction("Back", v.historyBackCmd, true),
+		tcell.KeyEnter:  ui.NewKeyAction("Diff", v.revisionDiffCmd, true),
+		ui.KeyU:         ui.NewKeyAction("Undo", v.undoCmd, true),
+	})
+	v.AddPage(historyPage, h, true, false)
+
+	return h
+}
+
+func (v *restartableResourceView) historyBackCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if h := v.historyPage(); !h.SearchBuff().Empty() {
+		h.SearchBuff().Reset()
+		return nil
+	}
+
+	return v.backCmd(evt)
+}
+
+func (v *restartableResourceView) revisionDiffCmd(evt *tcell.EventKey) *tcell.EventKey {
+	h := v.historyPage()
+	if h.SearchBuff().IsActive() {
+		return h.filterCmd(evt)
+	}
+	rev, ok := h.selectedRevision()
+	if !ok {
+		return evt
+	}
+	base, ok := h.diffBase(rev)
+	if !ok {
+		v.app.Flash().Infof("No revision to compare revision %d against", rev.Number)
+		return nil
+	}
+	if base.Number > rev.Number {
+		base, rev = rev, base
+	}
+	diff, err := revisionDiff(base, rev)
+	if err != nil {
+		v.app.Flash().Err(err)
+		return nil
+	}
+
+	details, ok := v.GetPrimitive(revisionDiffPage).(*detailsView)
+	if !ok {
+		details = newDetailsView(v.app, func(*tcell.EventKey) *tcell.EventKey {
+			v.switchPage(historyPage)
+			return nil
+		})
+		v.AddPage(revisionDiffPage, details, true, false)
+	}
+	details.setCategory("Diff")
+	details.setTitle(fmt.Sprintf("%d..%d", base.Number, rev.Number))
+	details.SetTextColor(v.app.Styles.FgColor())
+	details.SetText(diff)
+	details.ScrollToBeginning()
+	v.app.SetHints(details.hints())
+	v.switchPage(revisionDiffPage)
+
+	return nil
+}
+
+func (v *restartableResourceView) undoCmd(evt *tcell.EventKey) *tcell.EventKey {
+	rev, ok := v.historyPage().selectedRevision()
+	if !ok {
+		return evt
+	}
+	if rev.Current {
+		v.app.Flash().Warnf("Revision %d is already the current revision", rev.Number)
+		return nil
+	}
+
+	sel := v.masterPage().GetSelectedItem()
+	msg := fmt.Sprintf("Rollback %s to revision %d?", sel, rev.Number)
+	dialog.ShowConfirm(v.Pages, "<Confirm Undo>", msg, func() {
+		if err := v.rollback(sel, rev); err != nil {
+			v.app.Flash().Err(err)
+			return
+		}
+		v.app.Flash().Infof("Rolling back %s to revision %d...", sel, rev.Number)
+		v.switchPage("master")
+	}, func() {})
+
+	return nil
+}
+
+func (v *restartableResourceView) rollback(selection string, rev k8s.Revision) error {
+	r, ok := v.list.Resource().(resource.Rollbackable)
+	if !ok {
+		return errors.New("resource is not of type resource.Rollbackable")
+	}
+	ns, n := namespaced(selection)
+
+	return r.Rollback(ns, n, rev.Number)
+}
+
+func (v *restartableResourceView) pauseCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if !v.masterPage().RowSelected() {
+		return evt
+	}
+
+	sel := v.masterPage().GetSelectedItem()
+	r, ok := v.list.Resource().(resource.Pausable)
+	if !ok {
+		v.app.Flash().Err(errors.New("resource is not of type resource.Pausable"))
+		return nil
+	}
+	ns, n := namespaced(sel)
+	paused, err := r.IsPaused(ns, n)
+	if err != nil {
+		v.app.Flash().Err(err)
+		return nil
+	}
+
+	op, fn := "Pause", r.Pause
+	if paused {
+		op, fn = "Resume", r.Resume
+	}
+	msg := fmt.Sprintf("%s rollout for %s?", op, sel)
+	dialog.ShowConfirm(v.Pages, "<Confirm "+op+">", msg, func() {
+		if err := fn(ns, n); err != nil {
+			v.app.Flash().Err(err)
+			return
+		}
+		v.app.Flash().Infof("Rollout %sd for %s", strings.ToLower(op), sel)
+	}, func() {
+		v.showMaster()
+	})
+
+	return nil
+}
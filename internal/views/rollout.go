@@ -0,0 +1,128 @@
+package views
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/derailed/k9s/internal/k8s"
+	"github.com/derailed/k9s/internal/resource"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tview"
+	"github.com/gdamore/tcell"
+)
+
+const rolloutPage = "rollout"
+
+// RolloutView tracks a workload rollout progress.
+type rolloutView struct {
+	*detailsView
+
+	sel  string
+	done bool
+}
+
+func newRolloutView(app *appView, backFn ui.ActionHandler) *rolloutView {
+	v := rolloutView{detailsView: newDetailsView(app, backFn)}
+	v.setCategory("Rollout")
+
+	return &v
+}
+
+// RolloutCmd shows the rollout status of the selected resource.
+func (v *resourceView) rolloutCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if !v.masterPage().RowSelected() {
+		return evt
+	}
+	v.showRollout(v.masterPage().GetSelectedItem())
+
+	return nil
+}
+
+func (v *resourceView) showRollout(sel string) {
+	if _, ok := v.list.Resource().(resource.Trackable); !ok {
+		v.app.Flash().Err(errors.New("resource is not of type resource.Trackable"))
+		return
+	}
+
+	rv, ok := v.GetPrimitive(rolloutPage).(*rolloutView)
+	if !ok {
+		rv = newRolloutView(v.app, v.backCmd)
+		v.AddPage(rolloutPage, rv, true, false)
+	}
+	rv.sel, rv.done = sel, false
+	rv.setTitle(sel)
+	rv.SetTextColor(v.app.Styles.FgColor())
+	rv.SetText(fmt.Sprintf("[aqua::b]Waiting for %s rollout status...", sel))
+	v.app.SetHints(rv.hints())
+	v.switchPage(rolloutPage)
+	v.refreshRollout(rv)
+}
+
+// RefreshRollout updates the rollout panel from the reconciled list until
+// the rollout completes, fails or is paused.
+func (v *resourceView) refreshRollout(rv *rolloutView) {
+	if rv.done {
+		return
+	}
+	if err := v.list.Reconcile(v.app.informer, v.path); err != nil {
+		rv.SetText(fmt.Sprintf("[orangered::b]Unable to fetch rollout status: %s", tview.Escape(err.Error())))
+		return
+	}
+	i, _ := v.list.Item(rv.sel)
+	r, ok := i.(resource.Trackable)
+	if !ok {
+		rv.SetText(fmt.Sprintf("[orangered::b]Unable to find %s", rv.sel))
+		return
+	}
+
+	st := r.RolloutStatus()
+	rv.SetText(renderRollout(rv.sel, st, time.Now()))
+	rv.done = st.Done || st.Failed || st.Paused
+	switch {
+	case st.Failed:
+		v.app.Flash().Errf("Rollout failed for %s: %s", rv.sel, st.Message)
+	case st.Paused:
+		v.app.Flash().Warnf("Rollout paused for %s", rv.sel)
+	case st.Done:
+		v.app.Flash().Infof("Rollout complete for %s", rv.sel)
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func renderRollout(sel string, st k8s.RolloutStatus, now time.Time) string {
+	summary := fmt.Sprintf("[aqua::b]Rolling out %s...", sel)
+	switch {
+	case st.Failed:
+		summary = fmt.Sprintf("[orangered::b]Rollout failed for %s", sel)
+	case st.Paused:
+		summary = fmt.Sprintf("[orange::b]Rollout paused for %s", sel)
+	case st.Done:
+		summary = fmt.Sprintf("[green::b]Rollout complete for %s", sel)
+	}
+
+	lines := []string{
+		summary + "[-::-]",
+		"",
+		fmt.Sprintf("[khaki::b]%-12s[-::-]%d/%d", "Updated:", st.Updated, st.Desired),
+		fmt.Sprintf("[khaki::b]%-12s[-::-]%d/%d", "Ready:", st.Ready, st.Desired),
+		fmt.Sprintf("[khaki::b]%-12s[-::-]%d/%d", "Available:", st.Available, st.Desired),
+		fmt.Sprintf("[khaki::b]%-12s[-::-]%d", "Old:", st.Old),
+	}
+	if !st.Deadline.IsZero() && !st.Done && !st.Paused {
+		deadline := "exceeded"
+		if d := st.Deadline.Sub(now); d > 0 && !st.Failed {
+			deadline = "in " + d.Round(time.Second).String()
+		}
+		lines = append(lines, fmt.Sprintf("[khaki::b]%-12s[-::-]%s", "Deadline:", deadline))
+	}
+	lines = append(lines, "", tview.Escape(st.Message))
+	if st.Reason != "" {
+		lines = append(lines, "[orangered::]"+tview.Escape(st.Reason)+"[-::]")
+	}
+
+	return strings.Join(lines, "\n")
+}
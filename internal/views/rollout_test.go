@@ -0,0 +1,93 @@
+package views
+
+import (
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/k8s"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRenderRollout(t *testing.T) {
+	now := time.Date(2019, 10, 1, 0, 0, 0, 0, time.UTC)
+
+	uu := map[string]struct {
+		st k8s.RolloutStatus
+		e  string
+	}{
+		"progressing": {
+			st: k8s.RolloutStatus{
+				Desired:  3,
+				Updated:  1,
+				Old:      2,
+				Deadline: now.Add(90 * time.Second),
+				Message:  "Waiting for rollout to finish: 1 out of 3 new replicas have been updated...",
+				Reason:   "FailedCreate: exceeded quota [blee]",
+			},
+			e: "[aqua::b]Rolling out default/fred...[-::-]\n\n" +
+				"[khaki::b]Updated:    [-::-]1/3\n" +
+				"[khaki::b]Ready:      [-::-]0/3\n" +
+				"[khaki::b]Available:  [-::-]0/3\n" +
+				"[khaki::b]Old:        [-::-]2\n" +
+				"[khaki::b]Deadline:   [-::-]in 1m30s\n\n" +
+				"Waiting for rollout to finish: 1 out of 3 new replicas have been updated...\n" +
+				"[orangered::]FailedCreate: exceeded quota [blee[][-::]",
+		},
+		"failed": {
+			st: k8s.RolloutStatus{
+				Desired:  3,
+				Updated:  1,
+				Old:      2,
+				Deadline: now.Add(-time.Second),
+				Message:  "Deployment exceeded its progress deadline",
+				Failed:   true,
+			},
+			e: "[orangered::b]Rollout failed for default/fred[-::-]\n\n" +
+				"[khaki::b]Updated:    [-::-]1/3\n" +
+				"[khaki::b]Ready:      [-::-]0/3\n" +
+				"[khaki::b]Available:  [-::-]0/3\n" +
+				"[khaki::b]Old:        [-::-]2\n" +
+				"[khaki::b]Deadline:   [-::-]exceeded\n\n" +
+				"Deployment exceeded its progress deadline",
+		},
+		"done": {
+			st: k8s.RolloutStatus{
+				Desired:   3,
+				Updated:   3,
+				Ready:     3,
+				Available: 3,
+				Deadline:  now.Add(time.Minute),
+				Message:   "Successfully rolled out",
+				Done:      true,
+			},
+			e: "[green::b]Rollout complete for default/fred[-::-]\n\n" +
+				"[khaki::b]Updated:    [-::-]3/3\n" +
+				"[khaki::b]Ready:      [-::-]3/3\n" +
+				"[khaki::b]Available:  [-::-]3/3\n" +
+				"[khaki::b]Old:        [-::-]0\n\n" +
+				"Successfully rolled out",
+		},
+		"paused": {
+			st: k8s.RolloutStatus{
+				Desired:  3,
+				Updated:  1,
+				Old:      2,
+				Deadline: now.Add(time.Minute),
+				Message:  "Deployment rollout is paused",
+				Paused:   true,
+			},
+			e: "[orange::b]Rollout paused for default/fred[-::-]\n\n" +
+				"[khaki::b]Updated:    [-::-]1/3\n" +
+				"[khaki::b]Ready:      [-::-]0/3\n" +
+				"[khaki::b]Available:  [-::-]0/3\n" +
+				"[khaki::b]Old:        [-::-]2\n\n" +
+				"Deployment rollout is paused",
+		},
+	}
+
+	for k, u := range uu {
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, renderRollout("default/fred", u.st, now))
+		})
+	}
+}
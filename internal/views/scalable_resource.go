@@ -50,6 +50,10 @@ func (v *scalableResourceView) scale(selection string, replicas int) {
 	err := r.Scale(ns, n, int32(replicas))
 	if err != nil {
 		v.app.Flash().Err(err)
+		return
+	}
+	if _, ok := v.list.Resource().(resource.Trackable); ok {
+		v.showRollout(selection)
 	}
 }
 
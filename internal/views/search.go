@@ -0,0 +1,231 @@
+package views
+
+import (
+	"context"
+	"path"
+	"strings"
+
+	"github.com/derailed/k9s/internal/k8s"
+	"github.com/derailed/k9s/internal/resource"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/gdamore/tcell"
+	"github.com/rs/zerolog/log"
+)
+
+const searchTitle = "Search"
+
+var searchHeader = resource.Row{"KIND", "NAMESPACE", "NAME", "MATCH"}
+
+// Resources that are too noisy to be searched.
+var searchSkip = map[string]bool{
+	"v1/events":                    true,
+	"events.k8s.io/v1beta1/events": true,
+}
+
+type searchView struct {
+	*tableView
+
+	current   ui.Igniter
+	parentCtx context.Context
+	cancel    context.CancelFunc
+	ns        string
+	term      string
+	targets   []k8s.SearchTarget
+	hits      map[string]k8s.SearchHit
+}
+
+func newSearchView(app *appView, ns, term string) *searchView {
+	if ns == resource.AllNamespace {
+		ns = resource.AllNamespaces
+	}
+	v := searchView{ns: ns, term: term}
+	{
+		v.tableView = newTableView(app, searchTitle+":"+term)
+		v.SetColorerFn(searchColorer)
+		v.current = app.Frame().GetPrimitive("main").(ui.Igniter)
+		v.targets = searchTargets(app.command.load())
+		v.bindKeys()
+	}
+
+	return &v
+}
+
+// Init the view.
+func (v *searchView) Init(c context.Context, _ string) {
+	v.SetSortCol(0, len(searchHeader), true)
+
+	v.parentCtx = c
+	var ctx context.Context
+	ctx, v.cancel = context.WithCancel(c)
+	v.Update(v.tableData(nil))
+	go v.search(ctx)
+
+	v.app.SetFocus(v)
+	v.app.SetHints(v.Hints())
+}
+
+func (v *searchView) bindKeys() {
+	v.RmAction(ui.KeyShiftA)
+	v.RmAction(ui.KeyShiftP)
+
+	v.SetActions(ui.KeyActions{
+		tcell.KeyEnter:  ui.NewKeyAction("Goto", v.gotoCmd, true),
+		tcell.KeyEscape: ui.NewKeyAction("Back", v.resetCmd, true),
+		tcell.KeyCtrlR:  ui.NewKeyAction("Refresh", v.refreshCmd, true),
+		ui.KeySlash:     ui.NewKeyAction("Filter", v.activateCmd, false),
+		ui.KeyP:         ui.NewKeyAction("Previous", v.app.prevCmd, false),
+		ui.KeyShiftK:    ui.NewKeyAction("Sort Kind", v.SortColCmd(0), false),
+		ui.KeyShiftS:    ui.NewKeyAction("Sort Namespace", v.SortColCmd(1), false),
+		ui.KeyShiftN:    ui.NewKeyAction("Sort Name", v.SortColCmd(2), false),
+	})
+}
+
+func (v *searchView) search(ctx context.Context) {
+	v.app.QueueUpdateDraw(func() {
+		v.app.Flash().Infof("Searching for `%s`...", v.term)
+	})
+
+	hits, errs := k8s.Search(v.app.Conn(), v.targets, v.ns, v.term)
+	for _, err := range errs {
+		log.Warn().Err(err).Msg("Search failed")
+	}
+	if ctx.Err() != nil {
+		return
+	}
+
+	v.app.QueueUpdateDraw(func() {
+		v.hits = make(map[string]k8s.SearchHit, len(hits))
+		for _, h := range hits {
+			v.hits[searchKey(h.Kind, h.Namespace, h.Name)] = h
+		}
+		v.Update(v.tableData(hits))
+		v.SelectRow(1, true)
+		if len(errs) > 0 {
+			v.app.Flash().Warnf("Found %d matches for `%s` (%d resources could not be searched)", len(hits), v.term, len(errs))
+			return
+		}
+		v.app.Flash().Infof("Found %d matches for `%s`", len(hits), v.term)
+	})
+}
+
+func (v *searchView) tableData(hh []k8s.SearchHit) resource.TableData {
+	data := resource.TableData{
+		Header:    searchHeader,
+		Rows:      make(resource.RowEvents, len(hh)),
+		Namespace: "*",
+	}
+	noDeltas := make(resource.Row, len(searchHeader))
+	for _, h := range hh {
+		data.Rows[h.GVR+"|"+path.Join(h.Namespace, h.Name)] = &resource.RowEvent{
+			Action: resource.New,
+			Fields: resource.Row{h.Kind, h.Namespace, h.Name, resource.Truncate(h.Match, 80)},
+			Deltas: noDeltas,
+		}
+	}
+
+	return data
+}
+
+func (v *searchView) gotoCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if v.SearchBuff().IsActive() {
+		return v.filterCmd(evt)
+	}
+	if !v.RowSelected() {
+		return evt
+	}
+
+	r, _ := v.GetSelection()
+	h, ok := v.hits[searchKey(ui.TrimCell(v.Table, r, 0), ui.TrimCell(v.Table, r, 1), ui.TrimCell(v.Table, r, 2))]
+	if !ok {
+		return nil
+	}
+	cmd, ok := searchCmd(h)
+	if !ok {
+		v.app.Flash().Warnf("No command found for %s", h.GVR)
+		return nil
+	}
+	if v.cancel != nil {
+		v.cancel()
+	}
+	if !v.app.gotoResource(cmd, true) {
+		return nil
+	}
+	if rv, ok := v.app.Frame().GetPrimitive("main").(resourceViewer); ok {
+		tv := rv.masterPage()
+		if !tv.SelectItem(path.Join(h.Namespace, h.Name)) {
+			tv.SearchBuff().Set(relatedFilter([]string{h.Name}))
+			tv.Refresh()
+		}
+	}
+
+	return nil
+}
+
+func (v *searchView) refreshCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if v.cancel != nil {
+		v.cancel()
+	}
+	var ctx context.Context
+	ctx, v.cancel = context.WithCancel(v.parentCtx)
+	go v.search(ctx)
+
+	return nil
+}
+
+func (v *searchView) resetCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if !v.SearchBuff().Empty() {
+		v.SearchBuff().Reset()
+		return nil
+	}
+
+	return v.backCmd(evt)
+}
+
+func (v *searchView) backCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if v.cancel != nil {
+		v.cancel()
+	}
+
+	if v.SearchBuff().IsActive() {
+		v.SearchBuff().Reset()
+		return nil
+	}
+
+	v.app.inject(v.current)
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// SearchTargets returns all listable resources from the registered viewers.
+func searchTargets(vv viewers) []k8s.SearchTarget {
+	tt := make([]k8s.SearchTarget, 0, len(vv))
+	for gvr, v := range vv {
+		if v.gvr != gvr || searchSkip[gvr] || !hasVerb(v.verbs, "list") {
+			continue
+		}
+		tt = append(tt, k8s.SearchTarget{GVR: gvr, Kind: v.kind, Namespaced: v.namespaced})
+	}
+
+	return tt
+}
+
+// SearchCmd returns a command showing the resource of a given search hit.
+func searchCmd(h k8s.SearchHit) (string, bool) {
+	for _, a := range []string{k8s.GVR(h.GVR).ToR(), strings.ToLower(h.Kind)} {
+		if gvr, ok := aliases.Get(a); ok && gvr == h.GVR {
+			if h.Namespace == "" {
+				return a, true
+			}
+			return a + " " + h.Namespace, true
+		}
+	}
+
+	return "", false
+}
+
+func searchKey(kind, ns, n string) string {
+	return kind + "|" + path.Join(ns, n)
+}
@@ -0,0 +1,68 @@
+package views
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/derailed/k9s/internal/k8s"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestSearchTargets(t *testing.T) {
+	vv := viewers{
+		"v1/pods":         {gvr: "v1/pods", kind: "Pod", namespaced: true, verbs: metav1.Verbs{"get", "list"}},
+		"v1/nodes":        {gvr: "v1/nodes", kind: "Node", verbs: metav1.Verbs{"list"}},
+		"v1/events":       {gvr: "v1/events", kind: "Event", namespaced: true, verbs: metav1.Verbs{"list"}},
+		"v1/bindings":     {gvr: "v1/bindings", kind: "Binding", namespaced: true, verbs: metav1.Verbs{"create"}},
+		"v1/undiscovered": {},
+		"contexts":        {gvr: "contexts", kind: "Contexts"},
+		"fred.com/v1/crs": {gvr: "fred.com/v1/crs", kind: "CR", namespaced: true, verbs: crdVerbs},
+	}
+
+	tt := searchTargets(vv)
+	sort.Slice(tt, func(i, j int) bool { return tt[i].GVR < tt[j].GVR })
+	assert.Equal(t, []k8s.SearchTarget{
+		{GVR: "fred.com/v1/crs", Kind: "CR", Namespaced: true},
+		{GVR: "v1/nodes", Kind: "Node"},
+		{GVR: "v1/pods", Kind: "Pod", Namespaced: true},
+	}, tt)
+}
+
+func TestSearchCmd(t *testing.T) {
+	aliases.Define("fred.com/v1/blees", "blees")
+	aliases.Define("fred.com/v1/zorgs", "zorg")
+
+	uu := map[string]struct {
+		h   k8s.SearchHit
+		cmd string
+		ok  bool
+	}{
+		"namespaced": {
+			h:   k8s.SearchHit{GVR: "fred.com/v1/blees", Kind: "Blee", Namespace: "ns1", Name: "b1"},
+			cmd: "blees ns1",
+			ok:  true,
+		},
+		"cluster": {
+			h:   k8s.SearchHit{GVR: "fred.com/v1/blees", Kind: "Blee", Name: "b1"},
+			cmd: "blees",
+			ok:  true,
+		},
+		"kind": {
+			h:   k8s.SearchHit{GVR: "fred.com/v1/zorgs", Kind: "Zorg", Name: "z1"},
+			cmd: "zorg",
+			ok:  true,
+		},
+		"unknown": {
+			h: k8s.SearchHit{GVR: "fred.com/v1/duhs", Kind: "Duh", Name: "d1"},
+		},
+	}
+
+	for k, u := range uu {
+		t.Run(k, func(t *testing.T) {
+			cmd, ok := searchCmd(u.h)
+			assert.Equal(t, u.ok, ok)
+			assert.Equal(t, u.cmd, cmd)
+		})
+	}
+}
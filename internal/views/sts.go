@@ -4,6 +4,7 @@ import (
 	"github.com/derailed/k9s/internal/k8s"
 	"github.com/derailed/k9s/internal/resource"
 	"github.com/derailed/k9s/internal/ui"
+	"github.com/gdamore/tcell"
 	"github.com/rs/zerolog/log"
 	v1 "k8s.io/api/apps/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -32,6 +33,9 @@ func (v *statefulSetView) extraActions(aa ui.KeyActions) {
 	v.logResourceView.extraActions(aa)
 	v.scalableResourceView.extraActions(aa)
 	v.restartableResourceView.extraActions(aa)
+	aa[ui.KeyI] = ui.NewKeyAction("Set Image", v.setImageCmd, true)
+	aa[ui.KeyShiftF] = ui.NewKeyAction("PortForward", v.portFwdCmd, true)
+	aa[ui.KeyX] = ui.NewKeyAction("XRay", xrayCmd(v.resourceView, k8s.XRayStatefulSet), true)
 	aa[ui.KeyShiftD] = ui.NewKeyAction("Sort Desired", v.sortColCmd(1, false), false)
 	aa[ui.KeyShiftC] = ui.NewKeyAction("Sort Current", v.sortColCmd(2, false), false)
 }
@@ -56,3 +60,24 @@ func (v *statefulSetView) showPods(app *appView, ns, res, sel string) {
 
 	showPods(app, ns, l.String(), "", v.backCmd)
 }
+
+func (v *statefulSetView) portFwdCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if !v.masterPage().RowSelected() {
+		return evt
+	}
+
+	ns, n := namespaced(v.masterPage().GetSelectedItem())
+	st, err := k8s.NewStatefulSet(v.app.Conn()).Get(ns, n)
+	if err != nil {
+		v.app.Flash().Errf("Unable to fetch statefulset %s", err)
+		return nil
+	}
+	l, err := metav1.LabelSelectorAsSelector(st.(*v1.StatefulSet).Spec.Selector)
+	if err != nil {
+		v.app.Flash().Errf("Selector failed %s", err)
+		return nil
+	}
+	showForward(v.app, v.Pages, fwTarget{ns: ns, selector: l.String()})
+
+	return nil
+}
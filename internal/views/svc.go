@@ -14,6 +14,7 @@ import (
 	"github.com/gdamore/tcell"
 	"github.com/rs/zerolog/log"
 	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/labels"
 )
 
 type svcView struct {
@@ -45,6 +46,7 @@ func (v *svcView) getSelection() string {
 
 func (v *svcView) extraActions(aa ui.KeyActions) {
 	aa[ui.KeyL] = ui.NewKeyAction("Logs", v.logsCmd, true)
+	aa[ui.KeyShiftF] = ui.NewKeyAction("PortForward", v.portFwdCmd, true)
 	aa[tcell.KeyCtrlB] = ui.NewKeyAction("Bench", v.benchCmd, true)
 	aa[tcell.KeyCtrlK] = ui.NewKeyAction("Bench Stop", v.benchStopCmd, true)
 	aa[ui.KeyShiftT] = ui.NewKeyAction("Sort Type", v.sortColCmd(1, false), false)
@@ -86,6 +88,31 @@ func (v *svcView) logsCmd(evt *tcell.EventKey) *tcell.EventKey {
 	return nil
 }
 
+func (v *svcView) portFwdCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if !v.masterPage().RowSelected() {
+		return evt
+	}
+
+	ns, n := namespaced(v.getSelection())
+	svc, err := k8s.NewService(v.app.Conn()).Get(ns, n)
+	if err != nil {
+		v.app.Flash().Err(err)
+		return nil
+	}
+	s, ok := svc.(*v1.Service)
+	if !ok {
+		v.app.Flash().Errf("Expecting a service but got %T", svc)
+		return nil
+	}
+	showForward(v.app, v.Pages, fwTarget{
+		ns:       ns,
+		selector: labels.SelectorFromSet(s.Spec.Selector).String(),
+		svc:      s,
+	})
+
+	return nil
+}
+
 func (v *svcView) backCmd(evt *tcell.EventKey) *tcell.EventKey {
 	// Reset namespace to what it was
 	v.app.Config.SetActiveNamespace(v.list.GetNamespace())
@@ -193,6 +220,9 @@ func (v *svcView) benchDone() {
 	v.app.QueueUpdate(func() {
 		if v.bench.Canceled() {
 			v.app.status(ui.FlashInfo, "Benchmark canceled")
+		} else if v.bench.StoppedEarly() {
+			v.app.status(ui.FlashWarn, "Benchmark ran out of requests before its duration!")
+			v.bench.Cancel()
 		} else {
 			v.app.status(ui.FlashInfo, "Benchmark Completed!")
 			v.bench.Cancel()
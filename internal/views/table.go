@@ -1,7 +1,9 @@
 package views
 
 import (
+	"github.com/derailed/k9s/internal/resource"
 	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/k9s/internal/ui/dialog"
 	"github.com/gdamore/tcell"
 )
 
@@ -10,6 +12,7 @@ type tableView struct {
 
 	app      *appView
 	filterFn func(string)
+	objectFn objectFn
 }
 
 func newTableView(app *appView, title string) *tableView {
@@ -33,15 +36,45 @@ func (v *tableView) BufferActive(state bool, k ui.BufferKind) {
 }
 
 func (v *tableView) saveCmd(evt *tcell.EventKey) *tcell.EventKey {
-	if path, err := saveTable(v.app.Config.K9s.CurrentCluster, v.GetBaseTitle(), v.GetFilteredData()); err != nil {
-		v.app.Flash().Err(err)
-	} else {
-		v.app.Flash().Infof("File %s saved successfully!", path)
-	}
+	dialog.ShowExport(v.app.Frame(), v.HasMarks(), v.objectFn != nil, v.export)
 
 	return nil
 }
 
+func (v *tableView) export(opts dialog.ExportOpts) {
+	data := v.GetFilteredData()
+	if opts.Marked {
+		data = v.GetMarkedData()
+	}
+	cluster, title, keys, objFn := v.app.Config.K9s.CurrentCluster, v.GetBaseTitle(), v.GetSortedKeys(data), v.objectFn
+	// Snapshot the rows fields as the list updates them in place on refresh.
+	rows := make(resource.RowEvents, len(keys))
+	for _, k := range keys {
+		re := data.Rows[k]
+		rows[k] = &resource.RowEvent{Action: re.Action, Fields: append(resource.Row(nil), re.Fields...)}
+	}
+	data.Rows, data.Header = rows, append(resource.Row(nil), data.Header...)
+	if opts.Full && objFn != nil {
+		v.app.Flash().Infof("Exporting %d %s...", len(keys), title)
+	}
+	// Full exports fetch each resource from the api server, keep the UI responsive.
+	go func() {
+		path, err := exportTable(cluster, title, data, keys, opts, objFn)
+		v.app.QueueUpdateDraw(func() {
+			if err != nil {
+				v.app.Flash().Err(err)
+				return
+			}
+			v.app.Flash().Infof("File %s saved successfully!", path)
+		})
+	}()
+}
+
+// SetObjectFn enables full resource exports.
+func (v *tableView) setObjectFn(f objectFn) {
+	v.objectFn = f
+}
+
 func (v *tableView) setFilterFn(fn func(string)) {
 	v.filterFn = fn
 
@@ -1,9 +1,12 @@
 package views
 
 import (
+	"bytes"
 	"encoding/csv"
+	"encoding/json"
 	"fmt"
-	"os"
+	"io"
+	"io/ioutil"
 	"path/filepath"
 	"regexp"
 	"strings"
@@ -12,6 +15,8 @@ import (
 	"github.com/derailed/k9s/internal/config"
 	"github.com/derailed/k9s/internal/resource"
 	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/k9s/internal/ui/dialog"
+	"sigs.k8s.io/yaml"
 )
 
 const (
@@ -21,17 +26,29 @@ const (
 	labelSelIndicator = "-l"
 	descIndicator     = "↓"
 	ascIndicator      = "↑"
-	fullFmat          = "%s-%s-%d.csv"
-	noNSFmat          = "%s-%d.csv"
+	fullFmat          = "%s_%s_%d.%s"
+	noNSFmat          = "%s_%d.%s"
 )
 
 var (
 	cpuRX    = regexp.MustCompile(`\A.{0,1}CPU`)
 	memRX    = regexp.MustCompile(`\A.{0,1}MEM`)
 	labelCmd = regexp.MustCompile(`\A\-l`)
+
+	exportExts = map[string]string{
+		"csv":      "csv",
+		"json":     "json",
+		"yaml":     "yaml",
+		"markdown": "md",
+	}
 )
 
-type cleanseFn func(string) string
+type (
+	cleanseFn func(string) string
+
+	// ObjectFn fetches a resource full manifest as YAML.
+	objectFn func(path string) (string, error)
+)
 
 func trimCellRelative(tv *tableView, row, col int) string {
 	return ui.TrimCell(tv.Table, row, tv.NameColIndex()+col)
@@ -46,44 +63,149 @@ func trimCellRelative(tv *tableView, row, col int) string {
 // 	return strings.TrimSpace(c.Text)
 // }
 
-func saveTable(cluster, name string, data resource.TableData) (string, error) {
+func exportTable(cluster, name string, data resource.TableData, keys []string, opts dialog.ExportOpts, objFn objectFn) (string, error) {
 	dir := filepath.Join(config.K9sDumpDir, cluster)
 	if err := ensureDir(dir); err != nil {
 		return "", err
 	}
 
+	ext, ok := exportExts[opts.Format]
+	if !ok {
+		return "", fmt.Errorf("Unsupported export format %q", opts.Format)
+	}
 	ns, now := data.Namespace, time.Now().UnixNano()
 	if ns == resource.AllNamespaces {
 		ns = resource.AllNamespace
 	}
-	fName := fmt.Sprintf(fullFmat, name, ns, now)
+	fName := fmt.Sprintf(fullFmat, name, ns, now, ext)
 	if ns == resource.NotNamespaced {
-		fName = fmt.Sprintf(noNSFmat, name, now)
+		fName = fmt.Sprintf(noNSFmat, name, now, ext)
 	}
 
+	buff := new(bytes.Buffer)
+	if err := writeExport(buff, data, keys, opts, objFn); err != nil {
+		return "", err
+	}
 	path := filepath.Join(dir, fName)
-	mod := os.O_CREATE | os.O_WRONLY
-	file, err := os.OpenFile(path, mod, 0644)
-	defer func() {
-		if file != nil {
-			file.Close()
+
+	return path, ioutil.WriteFile(path, buff.Bytes(), 0644)
+}
+
+func writeExport(w io.Writer, data resource.TableData, keys []string, opts dialog.ExportOpts, objFn objectFn) error {
+	if opts.Full && objFn != nil && (opts.Format == "json" || opts.Format == "yaml") {
+		return writeObjects(w, keys, opts.Format, objFn)
+	}
+
+	switch opts.Format {
+	case "json":
+		raw, err := json.MarshalIndent(tableRecords(data, keys), "", "  ")
+		if err != nil {
+			return err
 		}
-	}()
-	if err != nil {
-		return "", err
+		_, err = fmt.Fprintln(w, string(raw))
+		return err
+	case "yaml":
+		raw, err := yaml.Marshal(tableRecords(data, keys))
+		if err != nil {
+			return err
+		}
+		_, err = w.Write(raw)
+		return err
+	case "markdown":
+		return writeMarkdown(w, data, keys)
+	default:
+		return writeCSV(w, data, keys)
 	}
+}
 
-	w := csv.NewWriter(file)
-	w.Write(data.Header)
-	for _, r := range data.Rows {
-		w.Write(r.Fields)
+func writeCSV(w io.Writer, data resource.TableData, keys []string) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write(data.Header); err != nil {
+		return err
 	}
-	w.Flush()
-	if err := w.Error(); err != nil {
-		return "", err
+	for _, k := range keys {
+		if err := cw.Write(data.Rows[k].Fields); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+
+	return cw.Error()
+}
+
+func writeMarkdown(w io.Writer, data resource.TableData, keys []string) error {
+	mdRow := func(ss []string) string {
+		cc := make([]string, len(ss))
+		for i, s := range ss {
+			cc[i] = strings.Replace(s, "|", `\|`, -1)
+		}
+		return "| " + strings.Join(cc, " | ") + " |"
+	}
+
+	sep := make([]string, len(data.Header))
+	for i := range sep {
+		sep[i] = "---"
+	}
+	lines := []string{mdRow(data.Header), mdRow(sep)}
+	for _, k := range keys {
+		lines = append(lines, mdRow(data.Rows[k].Fields))
+	}
+	_, err := fmt.Fprintln(w, strings.Join(lines, "\n"))
+
+	return err
+}
+
+// WriteObjects dumps the full resources for the given rows as a v1 List.
+func writeObjects(w io.Writer, keys []string, format string, objFn objectFn) error {
+	items := make([]interface{}, 0, len(keys))
+	for _, k := range keys {
+		raw, err := objFn(k)
+		if err != nil {
+			return err
+		}
+		var o interface{}
+		if err := yaml.Unmarshal([]byte(raw), &o); err != nil {
+			return err
+		}
+		items = append(items, o)
+	}
+	list := map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "List",
+		"items":      items,
+	}
+
+	var (
+		raw []byte
+		err error
+	)
+	if format == "json" {
+		raw, err = json.MarshalIndent(list, "", "  ")
+	} else {
+		raw, err = yaml.Marshal(list)
+	}
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintln(w, strings.TrimSpace(string(raw)))
+
+	return err
+}
+
+// TableRecords converts table rows into header keyed records.
+func tableRecords(data resource.TableData, keys []string) []map[string]string {
+	rr := make([]map[string]string, 0, len(keys))
+	for _, k := range keys {
+		r := make(map[string]string, len(data.Header))
+		for i, h := range data.Header {
+			if i < len(data.Rows[k].Fields) {
+				r[h] = data.Rows[k].Fields[i]
+			}
+		}
+		rr = append(rr, r)
 	}
 
-	return path, nil
+	return rr
 }
 
 func isLabelSelector(s string) bool {
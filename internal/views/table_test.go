@@ -1,14 +1,17 @@
 package views
 
 import (
+	"bytes"
 	"fmt"
 	"io/ioutil"
 	"path/filepath"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/derailed/k9s/internal/config"
 	"github.com/derailed/k9s/internal/resource"
+	"github.com/derailed/k9s/internal/ui/dialog"
 	"github.com/stretchr/testify/assert"
 	"k8s.io/apimachinery/pkg/watch"
 )
@@ -19,10 +22,83 @@ func TestTableViewSave(t *testing.T) {
 	dir := filepath.Join(config.K9sDumpDir, v.app.Config.K9s.CurrentCluster)
 	c1, _ := ioutil.ReadDir(dir)
 	v.saveCmd(nil)
+	assert.True(t, v.app.Frame().HasPage("export"))
+	v.export(dialog.ExportOpts{Format: "markdown"})
 	c2, _ := ioutil.ReadDir(dir)
+	for i := 0; i < 100 && len(c2) == len(c1); i++ {
+		time.Sleep(10 * time.Millisecond)
+		c2, _ = ioutil.ReadDir(dir)
+	}
 	assert.Equal(t, len(c2), len(c1)+1)
 }
 
+func TestWriteExport(t *testing.T) {
+	data := resource.TableData{
+		Header: resource.Row{"NAMESPACE", "NAME", "STATUS"},
+		Rows: resource.RowEvents{
+			"ns1/a": &resource.RowEvent{Fields: resource.Row{"ns1", "a", "CrashLoopBackOff"}},
+			"ns1/b": &resource.RowEvent{Fields: resource.Row{"ns1", "b", "a|b"}},
+		},
+	}
+	objFn := func(path string) (string, error) {
+		return "kind: Pod\nmetadata:\n  name: " + path + "\n", nil
+	}
+	keys := []string{"ns1/b", "ns1/a"}
+
+	uu := map[string]struct {
+		opts  dialog.ExportOpts
+		objFn objectFn
+		e     string
+	}{
+		"csv": {
+			opts: dialog.ExportOpts{Format: "csv"},
+			e:    "NAMESPACE,NAME,STATUS\nns1,b,a|b\nns1,a,CrashLoopBackOff\n",
+		},
+		"markdown": {
+			opts: dialog.ExportOpts{Format: "markdown"},
+			e:    "| NAMESPACE | NAME | STATUS |\n| --- | --- | --- |\n| ns1 | b | a\\|b |\n| ns1 | a | CrashLoopBackOff |\n",
+		},
+		"json": {
+			opts: dialog.ExportOpts{Format: "json", Full: true},
+			e: `[
+  {
+    "NAME": "b",
+    "NAMESPACE": "ns1",
+    "STATUS": "a|b"
+  },
+  {
+    "NAME": "a",
+    "NAMESPACE": "ns1",
+    "STATUS": "CrashLoopBackOff"
+  }
+]
+`,
+		},
+		"yaml": {
+			opts: dialog.ExportOpts{Format: "yaml"},
+			e:    "- NAME: b\n  NAMESPACE: ns1\n  STATUS: a|b\n- NAME: a\n  NAMESPACE: ns1\n  STATUS: CrashLoopBackOff\n",
+		},
+		"fullYAML": {
+			opts:  dialog.ExportOpts{Format: "yaml", Full: true},
+			objFn: objFn,
+			e:     "apiVersion: v1\nitems:\n- kind: Pod\n  metadata:\n    name: ns1/b\n- kind: Pod\n  metadata:\n    name: ns1/a\nkind: List\n",
+		},
+		"fullCSV": {
+			opts:  dialog.ExportOpts{Format: "csv", Full: true},
+			objFn: objFn,
+			e:     "NAMESPACE,NAME,STATUS\nns1,b,a|b\nns1,a,CrashLoopBackOff\n",
+		},
+	}
+
+	for k, u := range uu {
+		t.Run(k, func(t *testing.T) {
+			buff := new(bytes.Buffer)
+			assert.Nil(t, writeExport(buff, data, keys, u.opts, u.objFn))
+			assert.Equal(t, u.e, buff.String())
+		})
+	}
+}
+
 func TestTableViewNew(t *testing.T) {
 	v := newTableView(NewApp(config.NewConfig(ks{})), "test")
 
@@ -0,0 +1,532 @@
+package views
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/derailed/k9s/internal/k8s"
+	"github.com/derailed/k9s/internal/resource"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/k9s/internal/ui/dialog"
+	"github.com/derailed/k9s/internal/watch"
+	"github.com/derailed/tview"
+	"github.com/gdamore/tcell"
+	"github.com/rs/zerolog/log"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+const (
+	xrayTitle    = "XRay"
+	xrayTitleFmt = " [aqua::b]%s([fuchsia::b]%s[aqua::-])[aqua::-] "
+	xrayCluster  = "Cluster"
+	// XRayPollRate throttles direct lists of the resources not cached by the informer.
+	xrayPollRate = 30 * time.Second
+)
+
+var xrayGlyphs = map[k8s.XRayStatus]string{
+	k8s.XRayOK:      "✔",
+	k8s.XRayPending: "◔",
+	k8s.XRayToast:   "✘",
+	k8s.XRayUnknown: "?",
+}
+
+type (
+	// XRayRef tracks a tree node resource and its owning pod if any.
+	xrayRef struct {
+		node *k8s.XRayNode
+		pod  string
+	}
+
+	// XRayResource tracks how to access a given tree node kind.
+	xrayResource struct {
+		gvr    string
+		listFn listFn
+	}
+
+	// XRayView presents resources ownership as a tree.
+	xrayView struct {
+		*tview.Pages
+
+		app      *appView
+		tree     *tview.TreeView
+		actions  ui.KeyActions
+		current  ui.Igniter
+		cancel   context.CancelFunc
+		ns       string
+		kind     string
+		path     string
+		expanded map[string]bool
+		list     resource.List
+		sel      string
+		src      *k8s.XRaySource
+		polled   time.Time
+		mx       sync.Mutex
+	}
+)
+
+var xrayResources = map[string]xrayResource{
+	k8s.XRayNamespace:      {"v1/namespaces", resource.NewNamespaceList},
+	k8s.XRayDeployment:     {"apps/v1/deployments", resource.NewDeploymentList},
+	k8s.XRayReplicaSet:     {"apps/v1/replicasets", resource.NewReplicaSetList},
+	k8s.XRayStatefulSet:    {"apps/v1/statefulsets", resource.NewStatefulSetList},
+	k8s.XRayDaemonSet:      {"apps/v1/daemonsets", resource.NewDaemonSetList},
+	k8s.XRayCronJob:        {"batch/v1beta1/cronjobs", resource.NewCronJobList},
+	k8s.XRayJob:            {"batch/v1/jobs", resource.NewJobList},
+	k8s.XRayPod:            {"v1/pods", resource.NewPodList},
+	k8s.XRayConfigMap:      {"v1/configmaps", resource.NewConfigMapList},
+	k8s.XRaySecret:         {"v1/secrets", resource.NewSecretList},
+	k8s.XRayPVC:            {"v1/persistentvolumeclaims", resource.NewPersistentVolumeClaimList},
+	k8s.XRayServiceAccount: {"v1/serviceaccounts", resource.NewServiceAccountList},
+}
+
+// NewXRayView returns an ownership tree rooted at a namespace or, when a kind
+// and path are given, at the matching workload.
+func newXRayView(app *appView, ns, kind, path string) *xrayView {
+	if ns == resource.AllNamespace {
+		ns = resource.AllNamespaces
+	}
+	v := xrayView{
+		Pages:    tview.NewPages(),
+		app:      app,
+		tree:     tview.NewTreeView(),
+		ns:       ns,
+		kind:     kind,
+		path:     path,
+		expanded: make(map[string]bool),
+	}
+	v.current = app.Frame().GetPrimitive("main").(ui.Igniter)
+
+	v.tree.SetBorder(true)
+	v.tree.SetBorderPadding(0, 0, 1, 1)
+	v.tree.SetBorderFocusColor(tcell.ColorSteelBlue)
+	v.tree.SetGraphicsColor(tcell.ColorCadetBlue)
+	v.tree.SetInputCapture(v.keyboard)
+	v.tree.SetTitle(fmt.Sprintf(xrayTitleFmt, xrayTitle, v.rootName()))
+	v.AddPage("master", v.tree, true, true)
+
+	details := newDetailsView(app, v.backCmd)
+	v.AddPage("details", details, true, false)
+	v.AddPage("logs", newLogsView(xrayTitle, app, &v), true, false)
+	v.registerActions()
+
+	return &v
+}
+
+// Init the view.
+func (v *xrayView) Init(c context.Context, _ string) {
+	ctx, cancel := context.WithCancel(c)
+	v.cancel = cancel
+	go func(ctx context.Context) {
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(time.Duration(v.app.Config.K9s.GetRefreshRate()) * time.Second):
+				v.refresh()
+			}
+		}
+	}(ctx)
+
+	go v.refresh()
+	v.app.SetFocus(v.tree)
+	v.app.SetHints(v.Hints())
+}
+
+// Hints returns the view hints.
+func (v *xrayView) Hints() ui.Hints {
+	return v.actions.Hints()
+}
+
+func (v *xrayView) registerActions() {
+	v.actions = ui.KeyActions{
+		tcell.KeyEscape: ui.NewKeyAction("Back", v.resetCmd, true),
+		tcell.KeyEnter:  ui.NewKeyAction("Toggle", v.toggleCmd, true),
+		ui.KeyD:         ui.NewKeyAction("Describe", v.describeCmd, true),
+		ui.KeyL:         ui.NewKeyAction("Logs", v.logsCmd, true),
+		ui.KeyS:         ui.NewKeyAction("Shell", v.shellCmd, true),
+		tcell.KeyCtrlD:  ui.NewKeyAction("Delete", v.deleteCmd, true),
+		tcell.KeyCtrlR:  ui.NewKeyAction("Refresh", v.refreshCmd, false),
+		ui.KeyP:         ui.NewKeyAction("Previous", v.app.prevCmd, false),
+	}
+}
+
+func (v *xrayView) keyboard(evt *tcell.EventKey) *tcell.EventKey {
+	key := evt.Key()
+	if key == tcell.KeyRune {
+		key = tcell.Key(evt.Rune())
+	}
+	if a, ok := v.actions[key]; ok {
+		log.Debug().Msgf(">> XRayView handled %s", tcell.KeyNames[key])
+		return a.Action(evt)
+	}
+
+	return evt
+}
+
+func (v *xrayView) rootName() string {
+	switch {
+	case v.path != "":
+		return v.path
+	case v.ns == "":
+		return resource.AllNamespace
+	default:
+		return v.ns
+	}
+}
+
+// XRayCmd shows the ownership tree of the selected workload.
+func xrayCmd(v *resourceView, kind string) ui.ActionHandler {
+	return func(evt *tcell.EventKey) *tcell.EventKey {
+		if !v.masterPage().RowSelected() {
+			return evt
+		}
+		v.app.inject(newXRayView(v.app, "", kind, v.masterPage().GetSelectedItem()))
+
+		return nil
+	}
+}
+
+// Refresh fetches the cluster resources and rebuilds the tree.
+func (v *xrayView) refresh() {
+	ns := v.ns
+	if v.path != "" {
+		ns, _ = namespaced(v.path)
+	}
+	src, err := v.source(ns)
+	if err != nil {
+		v.app.QueueUpdateDraw(func() {
+			v.app.Flash().Errf("XRay failed %s", err)
+		})
+		return
+	}
+	root := v.rootFor(k8s.BuildXRay(src))
+	v.app.QueueUpdateDraw(func() {
+		if root == nil {
+			v.app.Flash().Warnf("Unable to locate %s %s", v.kind, v.path)
+			root = &k8s.XRayNode{Kind: v.kind, Name: v.path, Status: k8s.XRayToast, Info: "not found"}
+		}
+		v.update(root)
+	})
+}
+
+// Source returns the tree resources. Pods come from the informer, the other
+// resources are listed at most once per poll period or when new pods show up.
+func (v *xrayView) source(ns string) (*k8s.XRaySource, error) {
+	oo, err := v.app.informer.List(watch.PodIndex, ns, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	pods := make([]v1.Pod, 0, len(oo))
+	for _, o := range oo {
+		pods = append(pods, *o.(*v1.Pod))
+	}
+
+	v.mx.Lock()
+	defer v.mx.Unlock()
+	if v.src != nil && time.Since(v.polled) < xrayPollRate && !hasNewPods(v.src.Pods, pods) {
+		src := *v.src
+		src.Pods = pods
+		return &src, nil
+	}
+	src, err := k8s.FetchXRay(v.app.Conn(), ns, pods)
+	if err != nil {
+		return nil, err
+	}
+	v.src, v.polled = src, time.Now()
+
+	return src, nil
+}
+
+func (v *xrayView) rootFor(nn []*k8s.XRayNode) *k8s.XRayNode {
+	if v.path != "" {
+		for _, n := range nn {
+			if f, ok := n.Find(v.kind, v.path); ok {
+				return f
+			}
+		}
+		return nil
+	}
+	if len(nn) == 1 {
+		return nn[0]
+	}
+
+	return &k8s.XRayNode{Kind: xrayCluster, Name: v.app.Config.K9s.CurrentCluster, Children: nn}
+}
+
+func (v *xrayView) update(root *k8s.XRayNode) {
+	var selKey string
+	if n := v.tree.GetCurrentNode(); n != nil {
+		selKey = n.GetReference().(xrayRef).key()
+	}
+	v.saveExpanded(v.tree.GetRoot())
+
+	var sel *tview.TreeNode
+	tn := v.hydrate(root, "", 0, selKey, &sel)
+	v.tree.SetRoot(tn)
+	if sel == nil {
+		sel = tn
+	}
+	v.tree.SetCurrentNode(sel)
+}
+
+func (v *xrayView) saveExpanded(n *tview.TreeNode) {
+	if n == nil {
+		return
+	}
+	n.Walk(func(node, _ *tview.TreeNode) bool {
+		v.expanded[node.GetReference().(xrayRef).key()] = node.IsExpanded()
+		return true
+	})
+}
+
+// Hydrate converts an xray node into a tree node, restoring previous
+// expansion and selection.
+func (v *xrayView) hydrate(n *k8s.XRayNode, pod string, level int, selKey string, sel **tview.TreeNode) *tview.TreeNode {
+	ref := xrayRef{node: n, pod: pod}
+	tn := tview.NewTreeNode(xrayLabel(n))
+	tn.SetReference(ref)
+	tn.SetColor(xrayColor(n.Rollup()))
+	expanded, ok := v.expanded[ref.key()]
+	if !ok {
+		expanded = level < 2 && n.Kind != k8s.XRayPod
+	}
+	tn.SetExpanded(expanded)
+	if ref.key() == selKey {
+		*sel = tn
+	}
+
+	if n.Kind == k8s.XRayPod {
+		pod = n.Path()
+	}
+	for _, c := range n.Children {
+		tn.AddChild(v.hydrate(c, pod, level+1, selKey, sel))
+	}
+
+	return tn
+}
+
+func (v *xrayView) selectedRef() (xrayRef, bool) {
+	n := v.tree.GetCurrentNode()
+	if n == nil {
+		return xrayRef{}, false
+	}
+	ref, ok := n.GetReference().(xrayRef)
+
+	return ref, ok && ref.node != nil
+}
+
+func (v *xrayView) toggleCmd(evt *tcell.EventKey) *tcell.EventKey {
+	n := v.tree.GetCurrentNode()
+	if n == nil {
+		return evt
+	}
+	n.SetExpanded(!n.IsExpanded())
+
+	return nil
+}
+
+func (v *xrayView) refreshCmd(evt *tcell.EventKey) *tcell.EventKey {
+	v.mx.Lock()
+	v.polled = time.Time{}
+	v.mx.Unlock()
+	go v.refresh()
+
+	return nil
+}
+
+func (v *xrayView) describeCmd(evt *tcell.EventKey) *tcell.EventKey {
+	ref, ok := v.selectedRef()
+	if !ok {
+		return evt
+	}
+	kind, path := ref.node.Kind, ref.node.Path()
+	if kind == k8s.XRayContainer {
+		kind, path = k8s.XRayPod, ref.pod
+	}
+	res, ok := xrayResources[kind]
+	if !ok {
+		v.app.Flash().Warnf("Describe is not available on %s", kind)
+		return nil
+	}
+
+	raw, err := res.listFn(v.app.Conn(), resource.AllNamespaces).Resource().Describe(res.gvr, path)
+	if err != nil {
+		v.app.Flash().Errf("Describe command failed: %s", err)
+		return nil
+	}
+	details := v.detailsPage()
+	details.setCategory("Describe")
+	details.setTitle(path)
+	details.SetTextColor(v.app.Styles.FgColor())
+	details.SetText(colorizeYAML(v.app.Styles.Views().Yaml, raw))
+	details.ScrollToBeginning()
+	v.switchPage("details")
+
+	return nil
+}
+
+func (v *xrayView) logsCmd(evt *tcell.EventKey) *tcell.EventKey {
+	ref, ok := v.selectedRef()
+	if !ok {
+		return evt
+	}
+
+	var co string
+	switch ref.node.Kind {
+	case k8s.XRayContainer:
+		v.list, v.sel, co = resource.NewPodList(v.app.Conn(), resource.AllNamespaces), ref.pod, ref.node.Name
+	case k8s.XRayPod, k8s.XRayDeployment, k8s.XRayStatefulSet, k8s.XRayDaemonSet, k8s.XRayJob:
+		v.list, v.sel = xrayResources[ref.node.Kind].listFn(v.app.Conn(), resource.AllNamespaces), ref.node.Path()
+	default:
+		v.app.Flash().Warnf("Logs are not available on %s", ref.node.Kind)
+		return nil
+	}
+	v.GetPrimitive("logs").(*logsView).reload(co, v, false)
+	v.switchPage("logs")
+
+	return nil
+}
+
+func (v *xrayView) shellCmd(evt *tcell.EventKey) *tcell.EventKey {
+	ref, ok := v.selectedRef()
+	if !ok {
+		return evt
+	}
+
+	switch ref.node.Kind {
+	case k8s.XRayContainer:
+		shellIn(v.app, ref.pod, ref.node.Name)
+	case k8s.XRayPod:
+		var co string
+		if cc := ref.node.Children; len(cc) > 0 && cc[0].Kind == k8s.XRayContainer {
+			co = cc[0].Name
+		}
+		shellIn(v.app, ref.node.Path(), co)
+	default:
+		v.app.Flash().Warnf("Shell is not available on %s", ref.node.Kind)
+	}
+
+	return nil
+}
+
+func (v *xrayView) deleteCmd(evt *tcell.EventKey) *tcell.EventKey {
+	ref, ok := v.selectedRef()
+	if !ok {
+		return evt
+	}
+	res, ok := xrayResources[ref.node.Kind]
+	if !ok {
+		v.app.Flash().Warnf("Delete is not available on %s", ref.node.Kind)
+		return nil
+	}
+
+	path := ref.node.Path()
+	msg := fmt.Sprintf("Delete %s %s?", ref.node.Kind, path)
+	dialog.ShowDelete(v.Pages, msg, func(cascade, force bool) {
+		v.app.Flash().Infof("Delete resource %s %s", ref.node.Kind, path)
+		if err := res.listFn(v.app.Conn(), resource.AllNamespaces).Resource().Delete(path, cascade, force); err != nil {
+			v.app.Flash().Errf("Delete failed with %s", err)
+			return
+		}
+		go v.refresh()
+	}, func() {
+		v.switchPage("master")
+	})
+
+	return nil
+}
+
+func (v *xrayView) resetCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if v.cancel != nil {
+		v.cancel()
+	}
+	v.app.inject(v.current)
+
+	return nil
+}
+
+func (v *xrayView) backCmd(evt *tcell.EventKey) *tcell.EventKey {
+	v.switchPage("master")
+
+	return nil
+}
+
+func (v *xrayView) detailsPage() *detailsView {
+	return v.GetPrimitive("details").(*detailsView)
+}
+
+// Protocol...
+
+func (v *xrayView) getList() resource.List {
+	return v.list
+}
+
+func (v *xrayView) getSelection() string {
+	return v.sel
+}
+
+func (v *xrayView) switchPage(p string) {
+	v.SwitchToPage(p)
+	switch p {
+	case "master":
+		v.app.SetFocus(v.tree)
+		v.app.SetHints(v.Hints())
+	case "details":
+		v.app.SetHints(v.detailsPage().hints())
+	default:
+		if h, ok := v.GetPrimitive(p).(ui.Hinter); ok {
+			v.app.SetHints(h.Hints())
+		}
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func (r xrayRef) key() string {
+	if r.node == nil {
+		return ""
+	}
+
+	return r.pod + "|" + r.node.Kind + ":" + r.node.Path()
+}
+
+func xrayLabel(n *k8s.XRayNode) string {
+	l := fmt.Sprintf("%s %s %s", xrayGlyphs[n.Status], n.Kind, tview.Escape(n.Name))
+	if n.Info != "" {
+		l += " " + tview.Escape("["+n.Info+"]")
+	}
+
+	return l
+}
+
+func xrayColor(s k8s.XRayStatus) tcell.Color {
+	switch s {
+	case k8s.XRayToast:
+		return ui.ErrColor
+	case k8s.XRayPending:
+		return ui.AddColor
+	default:
+		return ui.StdColor
+	}
+}
+
+// HasNewPods checks if pods showed up since the given ones were polled. New pods may
+// refer to owners or resources that are not yet known.
+func hasNewPods(polled, pods []v1.Pod) bool {
+	uids := make(map[types.UID]bool, len(polled))
+	for _, po := range polled {
+		uids[po.UID] = true
+	}
+	for _, po := range pods {
+		if !uids[po.UID] {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,65 @@
+package views
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/k8s"
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestXRayLabel(t *testing.T) {
+	uu := map[string]struct {
+		n k8s.XRayNode
+		e string
+	}{
+		"ok": {
+			n: k8s.XRayNode{Kind: k8s.XRayDeployment, Namespace: "default", Name: "fred", Info: "1/1 ready"},
+			e: "✔ Deployment fred [1/1 ready]",
+		},
+		"toast": {
+			n: k8s.XRayNode{Kind: k8s.XRaySecret, Namespace: "default", Name: "blee", Status: k8s.XRayToast, Info: "missing"},
+			e: "✘ Secret blee [missing[]",
+		},
+		"noInfo": {
+			n: k8s.XRayNode{Kind: k8s.XRayNamespace, Name: "default"},
+			e: "✔ Namespace default",
+		},
+	}
+
+	for k, u := range uu {
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, xrayLabel(&u.n))
+		})
+	}
+}
+
+func TestXRayRefKey(t *testing.T) {
+	co := k8s.XRayNode{Kind: k8s.XRayContainer, Namespace: "default", Name: "c1"}
+	r1, r2 := xrayRef{node: &co, pod: "default/p1"}, xrayRef{node: &co, pod: "default/p2"}
+
+	assert.NotEqual(t, r1.key(), r2.key())
+	assert.Equal(t, "", xrayRef{}.key())
+}
+
+func TestHasNewPods(t *testing.T) {
+	p1 := v1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "p1", UID: "u1"}}
+	p2 := v1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "p2", UID: "u2"}}
+
+	uu := map[string]struct {
+		polled, pods []v1.Pod
+		e            bool
+	}{
+		"same":    {[]v1.Pod{p1, p2}, []v1.Pod{p2, p1}, false},
+		"deleted": {[]v1.Pod{p1, p2}, []v1.Pod{p1}, false},
+		"added":   {[]v1.Pod{p1}, []v1.Pod{p1, p2}, true},
+		"empty":   {nil, []v1.Pod{p1}, true},
+	}
+
+	for k, u := range uu {
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, hasNewPods(u.polled, u.pods))
+		})
+	}
+}